@@ -0,0 +1,135 @@
+package computation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/normalform"
+)
+
+// aigerWriter collects the and-gates of and-inverter graphs.  Structurally
+// equal sub-graphs are shared between all outputs.
+type aigerWriter struct {
+	fac     formula.Factory
+	vars    []formula.Variable
+	inputs  map[formula.Variable]uint64
+	gates   [][3]uint64
+	strash  map[[2]uint64]uint64
+	cache   map[formula.Formula]uint64
+	outputs []uint64
+}
+
+func newAIGERWriter(fac formula.Factory, fs []formula.Formula) *aigerWriter {
+	aigs := make([]formula.Formula, len(fs))
+	for i, f := range fs {
+		aigs[i] = f
+		for !normalform.IsAIG(fac, aigs[i]) {
+			aigs[i] = normalform.AIG(fac, aigs[i])
+		}
+	}
+	w := &aigerWriter{
+		fac:    fac,
+		vars:   formula.Variables(fac, aigs...).Content(),
+		inputs: make(map[formula.Variable]uint64),
+		strash: make(map[[2]uint64]uint64),
+		cache:  make(map[formula.Formula]uint64),
+	}
+	for i, v := range w.vars {
+		w.inputs[v] = 2 * uint64(i+1)
+	}
+	for _, aig := range aigs {
+		w.outputs = append(w.outputs, w.literal(aig))
+	}
+	return w
+}
+
+func (w *aigerWriter) literal(f formula.Formula) uint64 {
+	switch f.Sort() {
+	case formula.SortFalse:
+		return 0
+	case formula.SortTrue:
+		return 1
+	case formula.SortLiteral:
+		name, phase, _ := w.fac.LiteralNamePhase(f)
+		lit := w.inputs[w.fac.Var(name)]
+		if !phase {
+			lit ^= 1
+		}
+		return lit
+	case formula.SortNot:
+		op, _ := w.fac.NotOperand(f)
+		return w.literal(op) ^ 1
+	default:
+		if cached, ok := w.cache[f]; ok {
+			return cached
+		}
+		ops, _ := w.fac.NaryOperands(f)
+		result := w.literal(ops[0])
+		for _, op := range ops[1:] {
+			result = w.gate(result, w.literal(op))
+		}
+		w.cache[f] = result
+		return result
+	}
+}
+
+func (w *aigerWriter) gate(left, right uint64) uint64 {
+	if left < right {
+		left, right = right, left
+	}
+	if lhs, ok := w.strash[[2]uint64{left, right}]; ok {
+		return lhs
+	}
+	lhs := 2 * uint64(len(w.vars)+len(w.gates)+1)
+	w.gates = append(w.gates, [3]uint64{lhs, left, right})
+	w.strash[[2]uint64{left, right}] = lhs
+	return lhs
+}
+
+// write writes the and-inverter graphs in the ASCII or binary AIGER format
+// with input symbols from the variable names and output symbols from the
+// given descriptions.
+func (w *aigerWriter) write(binary bool, descriptions []string) []byte {
+	var buf bytes.Buffer
+	format := "aag"
+	if binary {
+		format = "aig"
+	}
+	numInputs := len(w.vars)
+	fmt.Fprintf(&buf, "%s %d %d 0 %d %d\n", format, numInputs+len(w.gates), numInputs, len(w.outputs), len(w.gates))
+	if !binary {
+		for i := range w.vars {
+			fmt.Fprintf(&buf, "%d\n", 2*(i+1))
+		}
+	}
+	for _, out := range w.outputs {
+		fmt.Fprintf(&buf, "%d\n", out)
+	}
+	for _, g := range w.gates {
+		if binary {
+			writeAIGERDelta(&buf, g[0]-g[1])
+			writeAIGERDelta(&buf, g[1]-g[2])
+		} else {
+			fmt.Fprintf(&buf, "%d %d %d\n", g[0], g[1], g[2])
+		}
+	}
+	for i, v := range w.vars {
+		name, _ := w.fac.VarName(v)
+		fmt.Fprintf(&buf, "i%d %s\n", i, name)
+	}
+	for i, desc := range descriptions {
+		if desc != "" {
+			fmt.Fprintf(&buf, "o%d %s\n", i, desc)
+		}
+	}
+	return buf.Bytes()
+}
+
+func writeAIGERDelta(buf *bytes.Buffer, delta uint64) {
+	for delta >= 0x80 {
+		buf.WriteByte(byte(delta&0x7f | 0x80))
+		delta >>= 7
+	}
+	buf.WriteByte(byte(delta))
+}
@@ -0,0 +1,296 @@
+package computation
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/sat"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// approxCounter implements hashing-based approximate model counting in the
+// style of ApproxMC.  Random XOR constraints over the counted variables split
+// the models into cells of roughly equal size.  The models of one cell are
+// counted by a SAT enumeration which stops at the threshold, the cell count
+// scaled by the number of cells is an estimate of the model count.
+type approxCounter struct {
+	fac       formula.Factory
+	solver    *sat.Solver
+	vars      []formula.Variable
+	threshold int
+	rnd       *rand.Rand
+	hdl       sat.Handler
+	last      int
+}
+
+// countApprox computes an (epsilon, delta) approximation of the model count
+// and writes it as result: with probability 1-delta the exact count lies
+// within a factor of 1+epsilon of the estimate.  If the formulas have fewer
+// models than the threshold, they are counted exactly.
+func countApprox(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	timeout time.Duration,
+) {
+	epsilon, ok := extractFloatParam(w, r, "epsilon", 0.8)
+	if !ok {
+		return
+	}
+	delta, ok := extractFloatParam(w, r, "delta", 0.2)
+	if !ok {
+		return
+	}
+	seed, ok := extractIntParam(w, r, "seed", int(time.Now().UnixMilli()))
+	if !ok {
+		return
+	}
+	if epsilon <= 0 || delta <= 0 || delta >= 1 {
+		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("epsilon must be positive and delta must be between 0 and 1")))
+		return
+	}
+
+	c := &approxCounter{
+		fac:       fac,
+		solver:    sat.NewSolver(fac),
+		vars:      formula.NewVarSet(vars...).Content(),
+		threshold: int(math.Ceil(1 + 9.84*(1+epsilon/(1+epsilon))*math.Pow(1+1/epsilon, 2))),
+		rnd:       rand.New(rand.NewSource(int64(seed))),
+		hdl:       satHandler(r, timeout),
+	}
+	c.solver.Add(formulas...)
+	count, ok := c.boundedCount(nil)
+	if !ok {
+		sio.WriteError(w, r, sio.ErrTimeout())
+		return
+	}
+	if count < c.threshold {
+		exact := big.NewInt(int64(count))
+		sio.WriteApproxCountResult(w, r, exact, exact, exact, 1, true)
+		return
+	}
+
+	iterations := int(math.Ceil(17 * math.Log2(3/delta)))
+	var estimates []*big.Int
+	for range iterations {
+		estimate, found, ok := c.estimate()
+		if !ok {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return
+		}
+		if found {
+			estimates = append(estimates, estimate)
+		}
+	}
+	if len(estimates) == 0 {
+		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("no hash function split the models into small enough cells")))
+		return
+	}
+	slices.SortFunc(estimates, func(a, b *big.Int) int { return a.Cmp(b) })
+	median := new(big.Float).SetInt(estimates[len(estimates)/2])
+	factor := big.NewFloat(1 + epsilon)
+	lower, _ := new(big.Float).Quo(median, factor).Int(nil)
+	upper, accuracy := new(big.Float).Mul(median, factor).Int(nil)
+	if accuracy == big.Below {
+		upper.Add(upper, big.NewInt(1))
+	}
+	value, _ := median.Int(nil)
+	sio.WriteApproxCountResult(w, r, value, lower, upper, 1-delta, false)
+}
+
+// estimate draws a random hash function of one XOR constraint per variable
+// and searches the smallest prefix of it whose cell has fewer models than the
+// threshold.  Since the cells of longer prefixes are nested, the cell counts
+// decrease with the prefix length.  The search starts at the prefix length of
+// the last estimate, which is usually close.
+func (c *approxCounter) estimate() (*big.Int, bool, bool) {
+	xors := make([]xorConstraint, len(c.vars))
+	for i := range xors {
+		xors[i] = c.randomXOR()
+	}
+	counts := make(map[int]int)
+	small := func(m int) (bool, bool) {
+		count, ok := counts[m]
+		if !ok {
+			if count, ok = c.boundedCount(xors[:m]); !ok {
+				return false, false
+			}
+			counts[m] = count
+		}
+		return count < c.threshold, true
+	}
+
+	low, high := 1, len(xors)
+	if c.last > 0 {
+		below, ok := small(c.last)
+		if !ok {
+			return nil, false, false
+		}
+		next := c.last + 1
+		if below {
+			next = c.last - 1
+		}
+		nextBelow := false
+		if next >= 1 && next <= len(xors) {
+			if nextBelow, ok = small(next); !ok {
+				return nil, false, false
+			}
+		}
+		switch {
+		case below && (next < 1 || !nextBelow):
+			low, high = c.last, c.last
+		case below:
+			high = next
+		case next <= len(xors) && nextBelow:
+			low, high = next, next
+		default:
+			low = min(next+1, len(xors))
+		}
+	}
+	for low < high {
+		mid := (low + high) / 2
+		below, ok := small(mid)
+		if !ok {
+			return nil, false, false
+		}
+		if below {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	if below, ok := small(low); !ok || !below {
+		return nil, false, ok
+	}
+	c.last = low
+	return new(big.Int).Lsh(big.NewInt(int64(counts[low])), uint(low)), true, true
+}
+
+// boundedCount counts the models of the formulas on the solver together with
+// the XOR constraints, but stops at the threshold.  The XOR constraints are
+// brought into reduced row echelon form first, since the SAT solver cannot
+// derive their linear combinations efficiently.
+func (c *approxCounter) boundedCount(xors []xorConstraint) (int, bool) {
+	state := c.solver.SaveState()
+	defer c.solver.LoadState(state)
+	for _, xor := range reduceXORs(xors) {
+		var vars []formula.Variable
+		for i, v := range c.vars {
+			if xor.vars.Bit(i) == 1 {
+				vars = append(vars, v)
+			}
+		}
+		c.solver.Add(xorCNF(c.fac, vars, xor.parity)...)
+	}
+	count := 0
+	for count < c.threshold {
+		result := c.solver.Call(sat.WithModel(c.vars).Handler(c.hdl))
+		if result.Aborted() {
+			return 0, false
+		}
+		if !result.Sat() {
+			break
+		}
+		count++
+		blocking := make([]formula.Literal, len(result.Model().Literals))
+		for i, lit := range result.Model().Literals {
+			blocking[i] = lit.Negate(c.fac)
+		}
+		c.solver.Add(c.fac.Clause(blocking...))
+	}
+	return count, true
+}
+
+// xorConstraint requires an odd (parity true) or even number of the variables
+// with a bit set in vars to be true.
+type xorConstraint struct {
+	vars   *big.Int
+	parity bool
+}
+
+// randomXOR returns an XOR constraint over a random subset of the variables
+// with a random parity.
+func (c *approxCounter) randomXOR() xorConstraint {
+	vars := new(big.Int)
+	for i := range c.vars {
+		vars.SetBit(vars, i, uint(c.rnd.Intn(2)))
+	}
+	return xorConstraint{vars, c.rnd.Intn(2) == 1}
+}
+
+// reduceXORs performs a Gauss-Jordan elimination on the XOR constraints.  In
+// the result each constraint has a pivot variable which occurs in no other
+// constraint.  Redundant constraints are removed, contradicting constraints
+// are returned as empty constraint with odd parity.
+func reduceXORs(xors []xorConstraint) []xorConstraint {
+	var rows []xorConstraint
+	for _, xor := range xors {
+		row := xorConstraint{new(big.Int).Set(xor.vars), xor.parity}
+		for _, other := range rows {
+			if row.vars.Bit(pivot(other)) == 1 {
+				row.vars.Xor(row.vars, other.vars)
+				row.parity = row.parity != other.parity
+			}
+		}
+		if row.vars.Sign() == 0 {
+			if row.parity {
+				return []xorConstraint{row}
+			}
+			continue
+		}
+		for i := range rows {
+			if rows[i].vars.Bit(pivot(row)) == 1 {
+				rows[i].vars.Xor(rows[i].vars, row.vars)
+				rows[i].parity = rows[i].parity != row.parity
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func pivot(xor xorConstraint) int {
+	return xor.vars.BitLen() - 1
+}
+
+// xorCNF encodes the constraint that an odd (parity true) or even number of
+// the variables is true.  Long constraints are cut into chunks of three
+// variables whose parity is stored in an auxiliary variable.
+func xorCNF(fac formula.Factory, vars []formula.Variable, parity bool) []formula.Formula {
+	var clauses []formula.Formula
+	for len(vars) > 4 {
+		aux := fac.NewAuxVar(formula.AuxCNF)
+		clauses = append(clauses, parityClauses(fac, []formula.Variable{aux, vars[0], vars[1], vars[2]}, false)...)
+		vars = append([]formula.Variable{aux}, vars[3:]...)
+	}
+	return append(clauses, parityClauses(fac, vars, parity)...)
+}
+
+// parityClauses excludes each assignment of the variables with the wrong
+// parity by one clause.
+func parityClauses(fac formula.Factory, vars []formula.Variable, parity bool) []formula.Formula {
+	var clauses []formula.Formula
+	for assignment := uint(0); assignment < 1<<len(vars); assignment++ {
+		if (bits.OnesCount(assignment)%2 == 1) == parity {
+			continue
+		}
+		lits := make([]formula.Literal, len(vars))
+		for i, v := range vars {
+			lits[i] = v.AsLiteral()
+			if assignment&(1<<i) != 0 {
+				lits[i] = v.Negate(fac)
+			}
+		}
+		clauses = append(clauses, fac.Clause(lits...))
+	}
+	return clauses
+}
@@ -48,6 +48,7 @@ func handleEvaluation(w http.ResponseWriter, r *http.Request) {
 // @Summary      Restrict formulas with an assignment of variables
 // @Description  If a list of formulas is given, the result is computed for each formula independently.
 // @Tags         Assignment
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.AssignmentInput true "Input formulas and variable assignment"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /assignment/restriction [post]
@@ -68,7 +69,7 @@ func handleRestriction(w http.ResponseWriter, r *http.Request) {
 	trans := func(fac formula.Factory, p *formula.StandardProposition) (formula.Formula, sio.ServiceError) {
 		return assignment.Restrict(fac, p.Formula(), ass), nil
 	}
-	transformPropostions(w, r, fac, trans, ps)
+	transformPropostions(w, r, fac, noNF, trans, ps)
 }
 
 func extractAssignment(fac formula.Factory, input map[string]bool) *assignment.Assignment {
@@ -0,0 +1,182 @@
+package computation
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+var astComparators = map[string]formula.CSort{
+	"=":  formula.EQ,
+	"<=": formula.LE,
+	"<":  formula.LT,
+	">=": formula.GE,
+	">":  formula.GT,
+}
+
+var astComparatorSymbols = map[formula.CSort]string{
+	formula.EQ: "=",
+	formula.LE: "<=",
+	formula.LT: "<",
+	formula.GE: ">=",
+	formula.GT: ">",
+}
+
+// sioFormula converts a formula to its service representation.  Depending on
+// the 'format' query parameter of the request, the formula is either printed
+// as string or returned as structured AST.
+func sioFormula(r *http.Request, fac formula.Factory, f formula.Formula, description ...string) sio.Formula {
+	var desc string
+	if len(description) > 0 {
+		desc = description[0]
+	}
+	if r.URL.Query().Get("format") == "ast" {
+		return sio.Formula{AST: formulaToAST(fac, f), Description: desc}
+	}
+	return sio.Formula{Formula: f.Sprint(fac), Description: desc}
+}
+
+func astToFormula(fac formula.Factory, ast *sio.FormulaAST) (formula.Formula, error) {
+	switch ast.Op {
+	case "true":
+		return fac.Verum(), nil
+	case "false":
+		return fac.Falsum(), nil
+	case "var":
+		if ast.Name == "" {
+			return 0, fmt.Errorf("variable without name")
+		}
+		return fac.Variable(ast.Name), nil
+	case "not":
+		ops, err := astOperands(fac, ast, 1)
+		if err != nil {
+			return 0, err
+		}
+		return fac.Not(ops[0]), nil
+	case "impl":
+		ops, err := astOperands(fac, ast, 2)
+		if err != nil {
+			return 0, err
+		}
+		return fac.Implication(ops[0], ops[1]), nil
+	case "equiv":
+		ops, err := astOperands(fac, ast, 2)
+		if err != nil {
+			return 0, err
+		}
+		return fac.Equivalence(ops[0], ops[1]), nil
+	case "and", "or":
+		ops, err := astOperands(fac, ast, -1)
+		if err != nil {
+			return 0, err
+		}
+		if ast.Op == "and" {
+			return fac.And(ops...), nil
+		}
+		return fac.Or(ops...), nil
+	case "cc":
+		comparator, ok := astComparators[ast.Comparator]
+		if !ok {
+			return 0, fmt.Errorf("unknown comparator '%s'", ast.Comparator)
+		}
+		if ast.RHS < 0 {
+			return 0, fmt.Errorf("cardinality constraint with negative right-hand side %d", ast.RHS)
+		}
+		return fac.CC(comparator, uint32(ast.RHS), fac.Vars(ast.Vars...)...), nil
+	case "pbc":
+		comparator, ok := astComparators[ast.Comparator]
+		if !ok {
+			return 0, fmt.Errorf("unknown comparator '%s'", ast.Comparator)
+		}
+		if len(ast.Lits) != len(ast.Coefficients) {
+			return 0, fmt.Errorf("pseudo-Boolean constraint with %d literals but %d coefficients",
+				len(ast.Lits), len(ast.Coefficients))
+		}
+		lits := make([]formula.Literal, len(ast.Lits))
+		coeffs := make([]int, len(ast.Coefficients))
+		for i, l := range ast.Lits {
+			lits[i] = fac.Lit(strings.TrimPrefix(l, "~"), !strings.HasPrefix(l, "~"))
+			coeffs[i] = int(ast.Coefficients[i])
+		}
+		return fac.PBC(comparator, int(ast.RHS), lits, coeffs), nil
+	default:
+		return 0, fmt.Errorf("unknown formula operator '%s'", ast.Op)
+	}
+}
+
+func astOperands(fac formula.Factory, ast *sio.FormulaAST, expected int) ([]formula.Formula, error) {
+	if expected >= 0 && len(ast.Ops) != expected {
+		return nil, fmt.Errorf("operator '%s' requires %d operands, but got %d", ast.Op, expected, len(ast.Ops))
+	}
+	ops := make([]formula.Formula, len(ast.Ops))
+	for i := range ast.Ops {
+		op, err := astToFormula(fac, &ast.Ops[i])
+		if err != nil {
+			return nil, err
+		}
+		ops[i] = op
+	}
+	return ops, nil
+}
+
+func formulaToAST(fac formula.Factory, f formula.Formula) *sio.FormulaAST {
+	switch f.Sort() {
+	case formula.SortFalse:
+		return &sio.FormulaAST{Op: "false"}
+	case formula.SortTrue:
+		return &sio.FormulaAST{Op: "true"}
+	case formula.SortLiteral:
+		name, phase, _ := fac.LiteralNamePhase(f)
+		variable := sio.FormulaAST{Op: "var", Name: name}
+		if phase {
+			return &variable
+		}
+		return &sio.FormulaAST{Op: "not", Ops: []sio.FormulaAST{variable}}
+	case formula.SortNot:
+		op, _ := fac.NotOperand(f)
+		return &sio.FormulaAST{Op: "not", Ops: []sio.FormulaAST{*formulaToAST(fac, op)}}
+	case formula.SortImpl, formula.SortEquiv:
+		left, right, _ := fac.BinaryLeftRight(f)
+		op := "impl"
+		if f.Sort() == formula.SortEquiv {
+			op = "equiv"
+		}
+		return &sio.FormulaAST{Op: op, Ops: []sio.FormulaAST{*formulaToAST(fac, left), *formulaToAST(fac, right)}}
+	case formula.SortAnd, formula.SortOr:
+		operands, _ := fac.NaryOperands(f)
+		ops := make([]sio.FormulaAST, len(operands))
+		for i, op := range operands {
+			ops[i] = *formulaToAST(fac, op)
+		}
+		op := "and"
+		if f.Sort() == formula.SortOr {
+			op = "or"
+		}
+		return &sio.FormulaAST{Op: op, Ops: ops}
+	case formula.SortCC:
+		comparator, rhs, lits, _, _ := fac.PBCOps(f)
+		vars := make([]string, len(lits))
+		for i, l := range lits {
+			vars[i], _, _ = fac.LitNamePhase(l)
+		}
+		return &sio.FormulaAST{Op: "cc", Vars: vars, Comparator: astComparatorSymbols[comparator], RHS: int64(rhs)}
+	default:
+		comparator, rhs, lits, coefficients, _ := fac.PBCOps(f)
+		litStrings := make([]string, len(lits))
+		coeffs := make([]int64, len(coefficients))
+		for i, l := range lits {
+			litStrings[i] = l.Sprint(fac)
+			coeffs[i] = int64(coefficients[i])
+		}
+		return &sio.FormulaAST{
+			Op:           "pbc",
+			Lits:         litStrings,
+			Coefficients: coeffs,
+			Comparator:   astComparatorSymbols[comparator],
+			RHS:          int64(rhs),
+		}
+	}
+}
@@ -2,7 +2,10 @@ package computation
 
 import (
 	"fmt"
+	"math"
 	"net/http"
+	"slices"
+	"strconv"
 
 	"github.com/booleworks/logicng-go/bdd"
 	"github.com/booleworks/logicng-go/formula"
@@ -13,19 +16,31 @@ import (
 )
 
 // @Summary      Compile formulas to a BDD
-// @Description  If a list of formulas is given, the BDD of the conjunction of these formulas is computed.
+// @Description  If a list of formulas is given, the BDD of the conjunction of these formulas is computed.  Variables of an explicit order in the input come first, the remaining variables are ordered by the ordering heuristic.  With the output 'bdd' the BDD is returned in a serialised form which can be sent to the BDD query endpoints.
 // @Tags         BDD
 // @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
-// @Param        request body	sio.FormulaInput true "Input formulas"
+// @Param        output query string  false "Output representation" Enums(graph, bdd) Default(graph)
+// @Param        reordering query string  false "Dynamic reordering method" Enums(none, win2, win2-ite, win3, win3-ite, sift, sift-ite) Default(none)
+// @Param        nodeTableSize query int  false "Initial size of the BDD node table, 0 keeps the default of 30 nodes per variable" Default(0)
+// @Param        request body	sio.BDDCompilationInput true "Input formulas and optional explicit variable order"
 // @Success      200  {object}  sio.GraphResult
 // @Router       /bdd/compilation [post]
 func HandleBDDCompilation(cfg *config.Config) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := r.URL.Query().Get("output")
+		if output != "" && output != "graph" && output != "bdd" {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown output '%s'", output)))
+			return
+		}
 		fac := formula.NewFactory()
 		bddRes, ok := compileBDD(w, r, cfg, fac)
 		if !ok {
 			return
 		}
+		if output == "bdd" {
+			sio.WriteBDDResult(w, r, serializeBDD(fac, bddRes))
+			return
+		}
 		rep := bddRes.NodeRepresentation()
 		nodeMap := make(map[bdd.Node]sio.Node)
 		nodes := make([]sio.Node, 0)
@@ -35,6 +50,61 @@ func HandleBDDCompilation(cfg *config.Config) http.Handler {
 	})
 }
 
+// @Summary      Compare the BDD variable orderings
+// @Description  Compiles the conjunction of the formulas with each ordering heuristic and, if given, with the explicit order of the input.  Reports the final variable order and the node count for each ordering, so the best ordering can be chosen.
+// @Tags         BDD
+// @Param        ordering query string  false "Ordering of the variables missing in the explicit order" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        reordering query string  false "Dynamic reordering method" Enums(none, win2, win2-ite, win3, win3-ite, sift, sift-ite) Default(none)
+// @Param        nodeTableSize query int  false "Initial size of the BDD node table, 0 keeps the default of 30 nodes per variable" Default(0)
+// @Param        request body	sio.BDDCompilationInput true "Input formulas and optional explicit variable order"
+// @Success      200  {object}  sio.BDDOrderingResult
+// @Router       /bdd/ordering [post]
+func HandleBDDOrdering(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fac := formula.NewFactory()
+		input, sErr := sio.Unmarshal[sio.BDDCompilationInput](r)
+		if sErr != nil {
+			sio.WriteError(w, r, sErr)
+			return
+		}
+		fs, ok := parseFormulas(w, r, fac, input.Formulas)
+		if !ok {
+			return
+		}
+		reordering, ok := parseReordering(w, r)
+		if !ok {
+			return
+		}
+		f := fac.And(fs...)
+		orderings := []string{"bfs", "dfs", "min2max", "max2min", "force"}
+		if len(input.Order) > 0 {
+			orderings = append(orderings, "explicit")
+		}
+		result := make([]sio.BDDOrdering, len(orderings))
+		for i, ordering := range orderings {
+			var order []formula.Variable
+			if ordering == "explicit" {
+				if order, ok = variableOrder(w, r, fac, f, input.Order); !ok {
+					return
+				}
+			} else {
+				order, _ = orderingHeuristic(fac, f, ordering)
+			}
+			bddRes, ok := compileWithOrder(w, r, cfg, fac, f, order, reordering)
+			if !ok {
+				return
+			}
+			finalOrder := bddRes.VariableOrder()
+			names := make([]string, len(finalOrder))
+			for j, v := range finalOrder {
+				names[j], _ = fac.VarName(v)
+			}
+			result[i] = sio.BDDOrdering{Ordering: ordering, Order: names, NodeCount: int64(bddRes.NodeCount())}
+		}
+		sio.WriteBDDOrderingResult(w, r, result)
+	})
+}
+
 func walkBDD(node bdd.Node, nodeMap *map[bdd.Node]sio.Node, nodes *[]sio.Node, edges *[]sio.Edge) int32 {
 	if n, ok := (*nodeMap)[node]; ok {
 		return n.ID
@@ -56,8 +126,10 @@ func walkBDD(node bdd.Node, nodeMap *map[bdd.Node]sio.Node, nodes *[]sio.Node, e
 // @Description  If a list of formulas is given, the BDD of the conjunction of these formulas is computed.
 // @Tags         BDD
 // @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        reordering query string  false "Dynamic reordering method" Enums(none, win2, win2-ite, win3, win3-ite, sift, sift-ite) Default(none)
+// @Param        nodeTableSize query int  false "Initial size of the BDD node table, 0 keeps the default of 30 nodes per variable" Default(0)
 // @Param        format query string  false "Output format" Enums(graphviz, mermaid) Default(mermaid)
-// @Param        request body	sio.FormulaInput true "Input formulas"
+// @Param        request body	sio.BDDCompilationInput true "Input formulas and optional explicit variable order"
 // @Success      200  {string}  graph string
 // @Router       /bdd/graphical [post]
 func HandleBDDGraphical(cfg *config.Config) http.Handler {
@@ -83,33 +155,215 @@ func HandleBDDGraphical(cfg *config.Config) http.Handler {
 }
 
 func compileBDD(w http.ResponseWriter, r *http.Request, cfg *config.Config, fac formula.Factory) (*bdd.BDD, bool) {
-	fs, ok := parseFormulaInput(w, r, fac)
+	input, sErr := sio.Unmarshal[sio.BDDCompilationInput](r)
+	if sErr != nil {
+		sio.WriteError(w, r, sErr)
+		return nil, false
+	}
+	fs, ok := parseFormulas(w, r, fac, input.Formulas)
+	if !ok {
+		return nil, false
+	}
+	f := fac.And(fs...)
+	order, ok := variableOrder(w, r, fac, f, input.Order)
+	if !ok {
+		return nil, false
+	}
+	reordering, ok := parseReordering(w, r)
 	if !ok {
 		return nil, false
 	}
+	return compileWithOrder(w, r, cfg, fac, f, order, reordering)
+}
+
+func compileFormulasToBDD(
+	w http.ResponseWriter,
+	r *http.Request,
+	cfg *config.Config,
+	fac formula.Factory,
+	fs []formula.Formula,
+) (*bdd.BDD, bool) {
 	f := fac.And(fs...)
+	order, ok := variableOrder(w, r, fac, f, nil)
+	if !ok {
+		return nil, false
+	}
+	return compileWithOrder(w, r, cfg, fac, f, order, bddReordering{})
+}
+
+// bddReordering configures the dynamic reordering of a BDD compilation.  The
+// kernel decides itself when to reorder the variables: whenever its node table
+// has to grow during the compilation.  The node table size is only the initial
+// size of this table, a size of 0 keeps the default size.
+type bddReordering struct {
+	method        bdd.ReorderingMethod
+	nodeTableSize int32
+}
+
+var reorderingMethods = map[string]bdd.ReorderingMethod{
+	"none":     bdd.ReorderNone,
+	"win2":     bdd.ReorderWin2,
+	"win2-ite": bdd.ReorderWin2Ite,
+	"win3":     bdd.ReorderWin3,
+	"win3-ite": bdd.ReorderWin3Ite,
+	"sift":     bdd.ReorderSift,
+	"sift-ite": bdd.ReorderSiftIte,
+}
 
+func parseReordering(w http.ResponseWriter, r *http.Request) (bddReordering, bool) {
+	var reordering bddReordering
+	if name := r.URL.Query().Get("reordering"); name != "" {
+		method, ok := reorderingMethods[name]
+		if !ok {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown reordering method '%s'", name)))
+			return reordering, false
+		}
+		reordering.method = method
+	}
+	if size := r.URL.Query().Get("nodeTableSize"); size != "" {
+		value, err := strconv.ParseInt(size, 10, 32)
+		if err != nil || value < 0 {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("illegal node table size '%s'", size)))
+			return reordering, false
+		}
+		reordering.nodeTableSize = int32(value)
+	}
+	return reordering, true
+}
+
+func compileWithOrder(
+	w http.ResponseWriter,
+	r *http.Request,
+	cfg *config.Config,
+	fac formula.Factory,
+	f formula.Formula,
+	order []formula.Variable,
+	reordering bddReordering,
+) (*bdd.BDD, bool) {
+	numVars := max(int32(len(order)), 1)
+	nodeSize := numVars * 30
+	if reordering.nodeTableSize > 0 {
+		nodeSize = reordering.nodeTableSize
+	}
+	kernel := bdd.NewKernelWithOrdering(fac, order, nodeSize, numVars*20)
+	if reordering.method != bdd.ReorderNone {
+		kernel.AddAllVariablesAsBlock()
+		kernel.ActivateReorderDuringBuild(reordering.method, math.MaxInt32)
+	}
 	hdl := bdd.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
-	var order []formula.Variable
-	switch ordering := r.URL.Query().Get("ordering"); ordering {
+	bddRes, ok := bdd.CompileWithKernelAndHandler(fac, f, kernel, hdl)
+	if !ok {
+		sio.WriteError(w, r, sio.ErrTimeout())
+		return nil, false
+	}
+	sio.StatsOf(r).SetBDD(bddRes)
+	return bddRes, true
+}
+
+// variableOrder computes the variable order for the formula.  The variables
+// of an explicit order come first, the remaining variables are ordered by the
+// heuristic given in the query.
+func variableOrder(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	f formula.Formula,
+	explicit []string,
+) ([]formula.Variable, bool) {
+	ordering := r.URL.Query().Get("ordering")
+	heuristic, err := orderingHeuristic(fac, f, ordering)
+	if err != nil {
+		sio.WriteError(w, r, sio.ErrIllegalInput(err))
+		return nil, false
+	}
+	if len(explicit) == 0 {
+		return heuristic, true
+	}
+	order := varsFromNames(fac, explicit)
+	for _, v := range heuristic {
+		if !slices.Contains(order, v) {
+			order = append(order, v)
+		}
+	}
+	return order, true
+}
+
+func orderingHeuristic(fac formula.Factory, f formula.Formula, ordering string) ([]formula.Variable, error) {
+	switch ordering {
 	case "bfs":
-		order = bdd.BFSOrder(fac, f)
+		return bdd.BFSOrder(fac, f), nil
 	case "dfs":
-		order = bdd.DFSOrder(fac, f)
+		return bdd.DFSOrder(fac, f), nil
 	case "min2max":
-		order = bdd.MinToMaxOrder(fac, f)
+		return bdd.MinToMaxOrder(fac, f), nil
 	case "max2min":
-		order = bdd.MaxToMinOrder(fac, f)
-	case "force":
-		order = bdd.ForceOrder(fac, f)
+		return bdd.MaxToMinOrder(fac, f), nil
+	case "force", "":
+		return bdd.ForceOrder(fac, f), nil
 	default:
-		sio.WriteError(w, r, sio.ErrUnknownPath(r.URL.Path))
-		return nil, false
+		return nil, fmt.Errorf("unknown variable ordering '%s'", ordering)
+	}
+}
+
+// serializeBDD writes the nodes of a BDD such that children precede their
+// parents.  The IDs 0 and 1 are reserved for the constants.
+func serializeBDD(fac formula.Factory, b *bdd.BDD) *sio.BDD {
+	order := b.VariableOrder()
+	result := &sio.BDD{Order: make([]string, len(order)), Nodes: []sio.BDDNode{}}
+	for i, v := range order {
+		result.Order[i], _ = fac.VarName(v)
+	}
+	ids := make(map[bdd.Node]int32)
+	var walk func(node bdd.Node) int32
+	walk = func(node bdd.Node) int32 {
+		if !node.InnerNode() {
+			if node.Label() == "$true" {
+				return 1
+			}
+			return 0
+		}
+		if id, ok := ids[node]; ok {
+			return id
+		}
+		low := walk(node.Low())
+		high := walk(node.High())
+		id := int32(len(result.Nodes) + 2)
+		result.Nodes = append(result.Nodes, sio.BDDNode{ID: id, Variable: node.Label(), Low: low, High: high})
+		ids[node] = id
+		return id
 	}
-	bddRes, ok := bdd.CompileWithVarOrderAndHandler(fac, f, order, hdl)
+	result.Root = walk(b.NodeRepresentation())
+	return result
+}
+
+// deserializeBDD rebuilds a serialised BDD in the given kernel.  The kernel's
+// variable ordering must contain the variable order of the serialised BDD.
+func deserializeBDD(fac formula.Factory, kernel *bdd.Kernel, serialized *sio.BDD) (*bdd.BDD, error) {
+	inOrder := make(map[string]bool, len(serialized.Order))
+	for _, name := range serialized.Order {
+		inOrder[name] = true
+	}
+	falsum := bdd.CompileLiterals(nil, kernel)
+	nodes := map[int32]*bdd.BDD{0: falsum, 1: falsum.Negate()}
+	for _, n := range serialized.Nodes {
+		if _, ok := nodes[n.ID]; ok {
+			return nil, fmt.Errorf("duplicate BDD node %d", n.ID)
+		}
+		if !inOrder[n.Variable] {
+			return nil, fmt.Errorf("variable '%s' of BDD node %d is not in the variable order", n.Variable, n.ID)
+		}
+		low, lowOk := nodes[n.Low]
+		high, highOk := nodes[n.High]
+		if !lowOk || !highOk {
+			return nil, fmt.Errorf("children of BDD node %d must be defined before the node", n.ID)
+		}
+		pos := bdd.CompileLiterals([]formula.Literal{fac.Lit(n.Variable, true)}, kernel)
+		neg := bdd.CompileLiterals([]formula.Literal{fac.Lit(n.Variable, false)}, kernel)
+		nodes[n.ID] = pos.And(high).Or(neg.And(low))
+	}
+	root, ok := nodes[serialized.Root]
 	if !ok {
-		sio.WriteError(w, r, sio.ErrTimeout())
-		return nil, false
+		return nil, fmt.Errorf("undefined BDD root %d", serialized.Root)
 	}
-	return bddRes, true
+	return root, nil
 }
@@ -0,0 +1,376 @@
+package computation
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/booleworks/logicng-go/bdd"
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/handler"
+	"github.com/booleworks/logicng-service/config"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+func HandleBDDQuery(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch query := r.PathValue("query"); query {
+		case "formula":
+			handleBDDFormula(w, r, cfg)
+		case "model-count":
+			handleBDDModelCount(w, r, cfg)
+		case "model":
+			handleBDDModel(w, r, cfg)
+		case "enumeration":
+			handleBDDEnumeration(w, r, cfg)
+		case "support":
+			handleBDDSupport(w, r, cfg)
+		case "node-count":
+			handleBDDNodeCount(w, r, cfg)
+		case "variable-profile":
+			handleBDDVariableProfile(w, r, cfg)
+		default:
+			sio.WriteError(w, r, sio.ErrUnknownPath(r.URL.Path))
+		}
+	})
+}
+
+// @Summary      Convert a BDD to a formula
+// @Description  Takes either formulas or a serialised BDD.  If a list of formulas is given, the BDD of the conjunction of these formulas is used.
+// @Tags         BDD
+// @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        request body	sio.BDDInput true "Input formulas or BDD"
+// @Success      200  {object}  sio.FormulaResult
+// @Router       /bdd/query/formula [post]
+func handleBDDFormula(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
+	fac := formula.NewFactory()
+	b, _, ok := parseBDDInput(w, r, cfg, fac)
+	if !ok {
+		return
+	}
+	sio.WriteFormulaResult(w, r, sioFormula(r, fac, b.ToFormula(fac)))
+}
+
+// @Summary      Count the models of a BDD
+// @Description  Takes either formulas or a serialised BDD.  If a list of formulas is given, the BDD of the conjunction of these formulas is used.  The models are counted over the variables of the input formulas or the serialised BDD's variable order.  Auxiliary variables of constraint encodings and further variables of the kernel, e.g. of the operand or the assignment, are not counted.
+// @Tags         BDD
+// @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        request body	sio.BDDInput true "Input formulas or BDD"
+// @Success      200  {object}  sio.StringResult
+// @Router       /bdd/query/model-count [post]
+func handleBDDModelCount(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
+	fac := formula.NewFactory()
+	b, input, ok := parseBDDInput(w, r, cfg, fac)
+	if !ok {
+		return
+	}
+	vars := formula.NewVarSet(input.vars...)
+	var quantified []formula.Variable
+	for _, v := range b.VariableOrder() {
+		if !vars.Contains(v) {
+			quantified = append(quantified, v)
+		}
+	}
+	if len(quantified) > 0 {
+		b = b.Exists(quantified...)
+	}
+	count := b.ModelCount()
+	sio.WriteStringResult(w, r, count.Rsh(count, uint(len(quantified))).String())
+}
+
+// @Summary      Compute a satisfying assignment of a BDD
+// @Description  Takes either formulas or a serialised BDD.  If variables are given, the model contains at least these variables, don't care variables are assigned to false.
+// @Tags         BDD
+// @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        request body	sio.BDDInput true "Input formulas or BDD and optional variables"
+// @Success      200  {object}  sio.SatResult
+// @Router       /bdd/query/model [post]
+func handleBDDModel(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
+	fac := formula.NewFactory()
+	b, input, ok := parseBDDInput(w, r, cfg, fac)
+	if !ok {
+		return
+	}
+	if b.IsContradiction() {
+		sio.WriteSatResult(w, r, false, nil, nil, nil)
+		return
+	}
+	mdl, _ := b.ModelWithVariables(false, varsFromNames(fac, input.Variables)...)
+	lits := make([]string, len(mdl.Literals))
+	for i, l := range mdl.Literals {
+		lits[i] = l.Sprint(fac)
+	}
+	sio.WriteSatResult(w, r, true, lits, nil, nil)
+}
+
+// @Summary      Enumerate the models of a BDD
+// @Description  Takes either formulas or a serialised BDD.  If variables are given, the models are projected to these variables, otherwise they range over all variables of the BDD's variable order.
+// @Tags         BDD
+// @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        request body	sio.BDDInput true "Input formulas or BDD and optional variables"
+// @Success      200  {object}  sio.FormulaResult
+// @Router       /bdd/query/enumeration [post]
+func handleBDDEnumeration(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
+	fac := formula.NewFactory()
+	b, input, ok := parseBDDInput(w, r, cfg, fac)
+	if !ok {
+		return
+	}
+	vars := varsFromNames(fac, input.Variables)
+	if len(vars) == 0 {
+		vars = b.VariableOrder()
+	}
+	models := b.ModelEnumeration(vars...)
+	formulas := make([]sio.Formula, len(models))
+	for i, m := range models {
+		formulas[i] = sioFormula(r, fac, m.Formula(fac))
+	}
+	sio.WriteFormulaResult(w, r, formulas...)
+}
+
+// @Summary      Compute the variables a BDD depends on
+// @Description  Takes either formulas or a serialised BDD.
+// @Tags         BDD
+// @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        request body	sio.BDDInput true "Input formulas or BDD"
+// @Success      200  {object}  sio.StringSetResult
+// @Router       /bdd/query/support [post]
+func handleBDDSupport(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
+	fac := formula.NewFactory()
+	b, _, ok := parseBDDInput(w, r, cfg, fac)
+	if !ok {
+		return
+	}
+	support := b.Support()
+	names := make([]string, len(support))
+	for i, v := range support {
+		names[i], _ = fac.VarName(v)
+	}
+	slices.Sort(names)
+	sio.WriteStringSetResult(w, r, names)
+}
+
+// @Summary      Compute the number of nodes of a BDD
+// @Description  Takes either formulas or a serialised BDD.  The constant nodes are not counted.
+// @Tags         BDD
+// @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        request body	sio.BDDInput true "Input formulas or BDD"
+// @Success      200  {object}  sio.IntResult
+// @Router       /bdd/query/node-count [post]
+func handleBDDNodeCount(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
+	fac := formula.NewFactory()
+	b, _, ok := parseBDDInput(w, r, cfg, fac)
+	if !ok {
+		return
+	}
+	sio.WriteIntResult(w, r, int64(b.NodeCount()))
+}
+
+// @Summary      Compute how often each variable occurs in a BDD
+// @Description  Takes either formulas or a serialised BDD.
+// @Tags         BDD
+// @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        request body	sio.BDDInput true "Input formulas or BDD"
+// @Success      200  {object}  sio.ProfileResult
+// @Router       /bdd/query/variable-profile [post]
+func handleBDDVariableProfile(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
+	fac := formula.NewFactory()
+	b, _, ok := parseBDDInput(w, r, cfg, fac)
+	if !ok {
+		return
+	}
+	profile := make(map[string]int64)
+	for v, count := range b.VariableProfile() {
+		name, _ := fac.VarName(v)
+		profile[name] = int64(count)
+	}
+	sio.WriteProfileResult(w, r, profile)
+}
+
+// @Summary      Apply an operation to BDDs
+// @Description  Takes either formulas or a serialised BDD.  The binary operations 'and', 'or', 'xor', and 'implies' require a second operand, 'restrict' requires an assignment, and 'exists' and 'forall' require variables.  The result is returned as serialised BDD or, with the output 'formula', as formula.
+// @Tags         BDD
+// @Param        op path string true "Operation" Enums(and, or, xor, implies, restrict, exists, forall)
+// @Param        ordering query string  false "Variable ordering" Enums(bfs, dfs, min2max, max2min, force) Default(force)
+// @Param        output query string  false "Output representation" Enums(bdd, formula) Default(bdd)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        request body	sio.BDDInput true "Input formulas or BDD with the arguments of the operation"
+// @Success      200  {object}  sio.BDDResult
+// @Router       /bdd/operation/{op} [post]
+func HandleBDDOperation(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op := r.PathValue("op")
+		binary := op == "and" || op == "or" || op == "xor" || op == "implies"
+		if !binary && op != "restrict" && op != "exists" && op != "forall" {
+			sio.WriteError(w, r, sio.ErrUnknownPath(r.URL.Path))
+			return
+		}
+		output := r.URL.Query().Get("output")
+		if output != "" && output != "bdd" && output != "formula" {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown output '%s'", output)))
+			return
+		}
+		fac := formula.NewFactory()
+		b, input, ok := parseBDDInput(w, r, cfg, fac)
+		if !ok {
+			return
+		}
+		if binary && input.Operand == nil {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("operation '%s' requires an operand", op)))
+			return
+		}
+		if !binary && input.Operand != nil {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("operation '%s' does not take an operand", op)))
+			return
+		}
+
+		var result *bdd.BDD
+		switch op {
+		case "and":
+			result = b.And(input.operand)
+		case "or":
+			result = b.Or(input.operand)
+		case "xor":
+			result = b.Equivalence(input.operand).Negate()
+		case "implies":
+			result = b.Implies(input.operand)
+		case "restrict":
+			names := sortedKeys(input.Assignment)
+			lits := make([]formula.Literal, len(names))
+			for i, name := range names {
+				lits[i] = fac.Lit(name, input.Assignment[name])
+			}
+			result = b.Restrict(lits...)
+		case "exists":
+			result = b.Exists(varsFromNames(fac, input.Variables)...)
+		case "forall":
+			result = b.ForAll(varsFromNames(fac, input.Variables)...)
+		}
+		if output == "formula" {
+			sio.WriteFormulaResult(w, r, sioFormula(r, fac, result.ToFormula(fac)))
+		} else {
+			sio.WriteBDDResult(w, r, serializeBDD(fac, result))
+		}
+	})
+}
+
+type parsedBDDInput struct {
+	sio.BDDInput
+	operand *bdd.BDD
+	vars    []formula.Variable
+}
+
+// parseBDDInput builds the BDD of the input and, if present, the BDD of its
+// operand.  Both BDDs share one kernel whose variable order starts with the
+// orders of serialised BDDs, followed by the order computed for the input
+// formulas, and the remaining variables of the input.  The variables of the
+// input's own formulas or serialised BDD are returned as well.
+func parseBDDInput(
+	w http.ResponseWriter,
+	r *http.Request,
+	cfg *config.Config,
+	fac formula.Factory,
+) (*bdd.BDD, parsedBDDInput, bool) {
+	input, sErr := sio.Unmarshal[sio.BDDInput](r)
+	if sErr != nil {
+		sio.WriteError(w, r, sErr)
+		return nil, parsedBDDInput{}, false
+	}
+	sources := []sio.BDDOperand{{Formulas: input.Formulas, BDD: input.BDD}}
+	if input.Operand != nil {
+		sources = append(sources, *input.Operand)
+	}
+
+	var order []formula.Variable
+	inOrder := make(map[formula.Variable]bool)
+	addToOrder := func(vars ...formula.Variable) {
+		for _, v := range vars {
+			if !inOrder[v] {
+				inOrder[v] = true
+				order = append(order, v)
+			}
+		}
+	}
+	parsed := make([]formula.Formula, len(sources))
+	var toCompile []formula.Formula
+	var inputVars []formula.Variable
+	for i, source := range sources {
+		if source.BDD != nil {
+			vars := varsFromNames(fac, source.BDD.Order)
+			addToOrder(vars...)
+			if i == 0 {
+				inputVars = slices.DeleteFunc(slices.Clone(vars), func(v formula.Variable) bool {
+					name, _ := fac.VarName(v)
+					return strings.HasPrefix(name, auxVarPrefix)
+				})
+			}
+			continue
+		}
+		fs, ok := parseFormulas(w, r, fac, source.Formulas)
+		if !ok {
+			return nil, parsedBDDInput{}, false
+		}
+		if i == 0 {
+			inputVars = formula.Variables(fac, fs...).Content()
+		}
+		parsed[i] = fac.And(fs...)
+		toCompile = append(toCompile, parsed[i])
+	}
+	if len(toCompile) > 0 {
+		formulaOrder, ok := variableOrder(w, r, fac, fac.And(toCompile...), nil)
+		if !ok {
+			return nil, parsedBDDInput{}, false
+		}
+		addToOrder(formulaOrder...)
+	}
+	addToOrder(varsFromNames(fac, input.Variables)...)
+	addToOrder(varsFromNames(fac, sortedKeys(input.Assignment))...)
+
+	numVars := max(int32(len(order)), 1)
+	kernel := bdd.NewKernelWithOrdering(fac, order, numVars*30, numVars*20)
+	hdl := bdd.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+	bdds := make([]*bdd.BDD, len(sources))
+	for i, source := range sources {
+		if source.BDD != nil {
+			b, err := deserializeBDD(fac, kernel, source.BDD)
+			if err != nil {
+				sio.WriteError(w, r, sio.ErrIllegalInput(err))
+				return nil, parsedBDDInput{}, false
+			}
+			bdds[i] = b
+			continue
+		}
+		b, ok := bdd.CompileWithKernelAndHandler(fac, parsed[i], kernel, hdl)
+		if !ok {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return nil, parsedBDDInput{}, false
+		}
+		bdds[i] = b
+	}
+	sio.StatsOf(r).SetBDD(bdds[0])
+	result := parsedBDDInput{BDDInput: input, vars: inputVars}
+	if len(bdds) > 1 {
+		result.operand = bdds[1]
+	}
+	return bdds[0], result, true
+}
+
+func varsFromNames(fac formula.Factory, names []string) []formula.Variable {
+	vars := make([]formula.Variable, len(names))
+	for i, name := range names {
+		vars[i] = fac.Var(name)
+	}
+	return vars
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
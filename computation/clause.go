@@ -0,0 +1,131 @@
+package computation
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+type normalForm byte
+
+const (
+	noNF normalForm = iota
+	cnfNF
+	dnfNF
+)
+
+// writeTransformed writes the result of a transformation.  If the result is
+// in the given normal form, the 'output' query parameter of the request
+// decides whether the formulas are written as formulas, as lists of string
+// literals, or as lists of integer literals with a variable table.
+func writeTransformed(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	nf normalForm,
+	fs []formula.Formula,
+	descriptions []string,
+) {
+	output := r.URL.Query().Get("output")
+	if nf == noNF || output == "formulas" || output == "" {
+		result := make([]sio.Formula, len(fs))
+		for i, f := range fs {
+			result[i] = sioFormula(r, fac, f, descriptions[i])
+		}
+		sio.WriteFormulaResult(w, r, result...)
+		return
+	}
+	if output != "clauses" && output != "int-clauses" {
+		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown output '%s'", output)))
+		return
+	}
+	varTable := newVarTable()
+	sets := make([]sio.ClauseSet, len(fs))
+	for i, f := range fs {
+		clauses, err := extractClauses(fac, f, nf)
+		if err != nil {
+			sio.WriteError(w, r, sio.ErrServer(err))
+			return
+		}
+		sets[i] = sio.ClauseSet{Description: descriptions[i]}
+		if output == "clauses" {
+			sets[i].Clauses = make([][]string, len(clauses))
+			for j, c := range clauses {
+				sets[i].Clauses[j] = make([]string, len(c))
+				for k, l := range c {
+					sets[i].Clauses[j][k] = l.Sprint(fac)
+				}
+			}
+		} else {
+			sets[i].IntClauses = make([][]int32, len(clauses))
+			for j, c := range clauses {
+				sets[i].IntClauses[j] = make([]int32, len(c))
+				for k, l := range c {
+					sets[i].IntClauses[j][k] = varTable.intLiteral(fac, l)
+				}
+			}
+		}
+	}
+	sio.WriteClauseResult(w, r, varTable.names, sets...)
+}
+
+// extractClauses returns the clauses of a CNF or the terms of a DNF as lists
+// of literals.
+func extractClauses(fac formula.Factory, f formula.Formula, nf normalForm) ([][]formula.Literal, error) {
+	outer, inner := formula.SortAnd, formula.SortOr
+	if nf == dnfNF {
+		outer, inner = formula.SortOr, formula.SortAnd
+	}
+	switch {
+	case f.Sort() == formula.SortTrue && nf == cnfNF, f.Sort() == formula.SortFalse && nf == dnfNF:
+		return [][]formula.Literal{}, nil
+	case f.IsConstant():
+		return [][]formula.Literal{{}}, nil
+	}
+	ops := []formula.Formula{f}
+	if f.Sort() == outer {
+		ops, _ = fac.NaryOperands(f)
+	}
+	clauses := make([][]formula.Literal, len(ops))
+	for i, op := range ops {
+		lits := []formula.Formula{op}
+		if op.Sort() == inner {
+			lits, _ = fac.NaryOperands(op)
+		}
+		clauses[i] = make([]formula.Literal, len(lits))
+		for j, l := range lits {
+			lit, err := l.AsLiteral()
+			if err != nil {
+				return nil, fmt.Errorf("formula '%s' is not in normal form", f.Sprint(fac))
+			}
+			clauses[i][j] = lit
+		}
+	}
+	return clauses, nil
+}
+
+type varTable struct {
+	names   []string
+	indices map[formula.Variable]int32
+}
+
+func newVarTable() *varTable {
+	return &varTable{indices: make(map[formula.Variable]int32)}
+}
+
+func (t *varTable) intLiteral(fac formula.Factory, lit formula.Literal) int32 {
+	name, phase, _ := fac.LitNamePhase(lit)
+	variable := fac.Var(name)
+	idx, ok := t.indices[variable]
+	if !ok {
+		t.names = append(t.names, name)
+		idx = int32(len(t.names))
+		t.indices[variable] = idx
+	}
+	if phase {
+		return idx
+	}
+	return -idx
+}
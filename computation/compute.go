@@ -11,6 +11,15 @@ func transform(
 	w http.ResponseWriter,
 	r *http.Request,
 	transformation func(formula.Factory, []formula.Formula) (formula.Formula, sio.ServiceError),
+) {
+	transformToNF(w, r, noNF, transformation)
+}
+
+func transformToNF(
+	w http.ResponseWriter,
+	r *http.Request,
+	nf normalForm,
+	transformation func(formula.Factory, []formula.Formula) (formula.Formula, sio.ServiceError),
 ) {
 	fac := formula.NewFactory()
 	fs, ok := parseFormulaInput(w, r, fac)
@@ -19,7 +28,7 @@ func transform(
 	}
 	transformed, err := transformation(fac, fs)
 	if err == nil {
-		sio.WriteFormulaResult(w, r, sio.Formula{Formula: transformed.Sprint(fac)})
+		writeTransformed(w, r, fac, nf, []formula.Formula{transformed}, []string{""})
 	} else {
 		sio.WriteError(w, r, err)
 	}
@@ -28,6 +37,7 @@ func transform(
 func transformPerFormula(
 	w http.ResponseWriter,
 	r *http.Request,
+	nf normalForm,
 	transformation func(formula.Factory, *formula.StandardProposition) (formula.Formula, sio.ServiceError),
 ) {
 	fac := formula.NewFactory()
@@ -35,29 +45,30 @@ func transformPerFormula(
 	if !ok {
 		return
 	}
-	transformPropostions(w, r, fac, transformation, ps)
+	transformPropostions(w, r, fac, nf, transformation, ps)
 }
 
 func transformPropostions(
 	w http.ResponseWriter,
 	r *http.Request,
 	fac formula.Factory,
+	nf normalForm,
 	transformation func(formula.Factory, *formula.StandardProposition) (formula.Formula, sio.ServiceError),
 	ps []*formula.StandardProposition,
 ) {
-	result := make([]sio.Formula, len(ps))
+	result := make([]formula.Formula, len(ps))
+	descriptions := make([]string, len(ps))
 	var err sio.ServiceError
 	for i, p := range ps {
-		var transformed formula.Formula
-		transformed, err = transformation(fac, p)
+		result[i], err = transformation(fac, p)
 		if err != nil {
 			break
 		}
-		result[i] = sio.Formula{Formula: transformed.Sprint(fac), Description: p.Description}
+		descriptions[i] = p.Description
 	}
 
 	if err == nil {
-		sio.WriteFormulaResult(w, r, result...)
+		writeTransformed(w, r, fac, nf, result, descriptions)
 	} else {
 		sio.WriteError(w, r, err)
 	}
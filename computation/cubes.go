@@ -0,0 +1,222 @@
+package computation
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/booleworks/logicng-go/bdd"
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/sat"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// enumerateCubes writes disjoint cubes over the variables which cover all
+// models.  The BDD algorithm returns the paths of the projected BDD, the SAT
+// algorithm reduces each model to a prime implicant.
+func enumerateCubes(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	timeout time.Duration,
+) {
+	if pagedEnumeration(r) {
+		sio.WriteError(w, r, sio.ErrIllegalInput(errors.New("cube output does not support pagination")))
+		return
+	}
+	vars = sortedByName(fac, vars)
+	var cubes [][]formula.Literal
+	var ok bool
+	switch algorithm := r.URL.Query().Get("algorithm"); algorithm {
+	case "bdd", "":
+		cubes, ok = cubesBDD(w, r, fac, formulas, vars, timeout)
+	case "sat":
+		cubes, ok = cubesSat(w, r, fac, formulas, vars, timeout)
+	default:
+		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown model enumeration algorithm '%s'", algorithm)))
+	}
+	if !ok {
+		return
+	}
+
+	names, result := sioCubes(fac, vars, cubes)
+	sio.WriteCubeResult(w, r, names, result)
+}
+
+// sioCubes returns the names of the variables and the cubes over them with
+// the literals in the order of the variables.
+func sioCubes(fac formula.Factory, vars []formula.Variable, cubes [][]formula.Literal) ([]string, []sio.Cube) {
+	index := make(map[formula.Variable]int, len(vars))
+	names := make([]string, len(vars))
+	for i, v := range vars {
+		index[v] = i
+		names[i], _ = fac.VarName(v)
+	}
+	result := make([]sio.Cube, len(cubes))
+	for i, cube := range cubes {
+		phases := make(map[int]bool, len(cube))
+		for _, lit := range cube {
+			phases[index[lit.Variable()]] = lit.IsPos()
+		}
+		literals := make([]string, 0, len(cube))
+		for pos, name := range names {
+			if phase, ok := phases[pos]; ok {
+				if !phase {
+					name = "~" + name
+				}
+				literals = append(literals, name)
+			}
+		}
+		count := new(big.Int).Lsh(big.NewInt(1), uint(len(vars)-len(cube)))
+		result[i] = sio.Cube{Literals: literals, Count: count.String()}
+	}
+	return names, result
+}
+
+func cubesBDD(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	timeout time.Duration,
+) ([][]formula.Literal, bool) {
+	projected, _, ok := compileProjectedBDD(w, r, fac, formulas, vars, timeout)
+	if !ok {
+		return nil, false
+	}
+	return bddCubes(fac, projected, 0), true
+}
+
+// bddCubes returns the paths of the BDD to the true node as cubes.  A
+// positive limit stops after this number of cubes.
+func bddCubes(fac formula.Factory, b *bdd.BDD, limit int) [][]formula.Literal {
+	serialized := serializeBDD(fac, b)
+	nodes := make(map[int32]sio.BDDNode, len(serialized.Nodes))
+	for _, n := range serialized.Nodes {
+		nodes[n.ID] = n
+	}
+	var cubes [][]formula.Literal
+	var path []formula.Literal
+	var walk func(id int32)
+	walk = func(id int32) {
+		switch id {
+		case 0:
+		case 1:
+			if limit > 0 && len(cubes) >= limit {
+				return
+			}
+			cubes = append(cubes, append([]formula.Literal{}, path...))
+		default:
+			node := nodes[id]
+			v := fac.Var(node.Variable)
+			path = append(path, v.Negate(fac))
+			walk(node.Low)
+			path[len(path)-1] = v.AsLiteral()
+			walk(node.High)
+			path = path[:len(path)-1]
+		}
+	}
+	walk(serialized.Root)
+	return cubes
+}
+
+// cubesSat enumerates models with a SAT solver and reduces each model to a
+// prime implicant before blocking it.  A literal of a projection variable is
+// removed if the remaining literals still imply the formula, which is checked
+// on a solver for its negation, and if the cube stays disjoint from all
+// previous cubes.  Literals of other variables are kept as witnesses, so the
+// projected cube implies the projected formula.
+func cubesSat(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	timeout time.Duration,
+) ([][]formula.Literal, bool) {
+	hdl := satHandler(r, timeout)
+	f := fac.And(formulas...)
+	modelVars := formula.Variables(fac, f).Content()
+	projection := formula.NewVarSet(vars...)
+	solver := sat.NewSolver(fac)
+	solver.Add(f)
+	negation := sat.NewSolver(fac)
+	negation.Add(fac.Not(f))
+
+	var cubes [][]formula.Literal
+	for {
+		result := solver.Call(sat.WithModel(modelVars).Handler(hdl))
+		if result.Aborted() {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return nil, false
+		}
+		if !result.Sat() {
+			return cubes, true
+		}
+		model := result.Model().Literals
+		implicant := make(map[formula.Variable]bool, len(model))
+		for _, lit := range model {
+			implicant[lit.Variable()] = lit.IsPos()
+		}
+		// current returns the literals of the implicant in the order of the model
+		current := func() []formula.Literal {
+			var lits []formula.Literal
+			for _, lit := range model {
+				if _, ok := implicant[lit.Variable()]; ok {
+					lits = append(lits, lit)
+				}
+			}
+			return lits
+		}
+		for _, lit := range model {
+			v := lit.Variable()
+			if !projection.Contains(v) {
+				continue
+			}
+			delete(implicant, v)
+			if !disjoint(implicant, cubes) {
+				implicant[v] = lit.IsPos()
+				continue
+			}
+			reduced := negation.Call(sat.WithAssumptions(current()).Handler(hdl))
+			if reduced.Aborted() {
+				sio.WriteError(w, r, sio.ErrTimeout())
+				return nil, false
+			}
+			if reduced.Sat() {
+				implicant[v] = lit.IsPos()
+			}
+		}
+		var cube, blocking []formula.Literal
+		for _, lit := range current() {
+			if projection.Contains(lit.Variable()) {
+				cube = append(cube, lit)
+				blocking = append(blocking, lit.Negate(fac))
+			}
+		}
+		cubes = append(cubes, cube)
+		solver.Add(fac.Clause(blocking...))
+	}
+}
+
+// disjoint reports whether the implicant contradicts each of the cubes.
+func disjoint(implicant map[formula.Variable]bool, cubes [][]formula.Literal) bool {
+	for _, cube := range cubes {
+		clash := false
+		for _, lit := range cube {
+			if phase, ok := implicant[lit.Variable()]; ok && phase != lit.IsPos() {
+				clash = true
+				break
+			}
+		}
+		if !clash {
+			return false
+		}
+	}
+	return true
+}
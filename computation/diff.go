@@ -0,0 +1,306 @@
+package computation
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+
+	"github.com/booleworks/logicng-go/bdd"
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/handler"
+	"github.com/booleworks/logicng-go/sat"
+	"github.com/booleworks/logicng-service/config"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// @Summary      Compute the semantic difference between two versions of a rule base
+// @Description  The models of both versions range over the variables of both versions.  The gained models satisfy only the new version, the lost models only the old version.  Their counts are computed on BDDs and up to 'limit' of them are returned as disjoint cubes.  Formulas which occur in only one version are added or removed formulas.  Gained models are caused by the removed formulas they violate, lost models by the added formulas they violate.  For each literal which is added to or removed from the backbone a minimal set of added or removed formulas is reported which implies it together with the common formulas.  Dead and forced variables are the variables which are newly false or true in all models of the new version.  An unsatisfiable version has an empty backbone.
+// @Tags         Analysis
+// @Param        limit query int  false "Maximum number of cubes of the gained and lost models" Default(10)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        request body	sio.DiffInput true "Old and new formulas"
+// @Success      200  {object}  sio.DiffResult
+// @Router       /analysis/diff [post]
+func HandleDiff(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, ok := extractIntParam(w, r, "limit", 10)
+		if !ok {
+			return
+		}
+		if limit < 0 {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("illegal limit value '%d'", limit)))
+			return
+		}
+		input, err := sio.Unmarshal[sio.DiffInput](r)
+		if err != nil {
+			sio.WriteError(w, r, err)
+			return
+		}
+		fac := formula.NewFactory()
+		useSolverConfig(fac, input.SolverConfig)
+		olds, ok := parseProps(w, r, fac, input.Old)
+		if !ok {
+			return
+		}
+		news, ok := parseProps(w, r, fac, input.New)
+		if !ok {
+			return
+		}
+		d := newRuleBaseDiff(r, fac, olds, news)
+		result := sio.DiffResult{
+			Added:   d.sioFormulas(r, d.added),
+			Removed: d.sioFormulas(r, d.removed),
+		}
+
+		oldBDD, newBDD, numQuantified, ok := d.compile(cfg)
+		if !ok {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return
+		}
+		gained, lost := newBDD.And(oldBDD.Negate()), oldBDD.And(newBDD.Negate())
+		result.Variables, result.Gained.Cubes = sioCubes(fac, d.vars, bddCubes(fac, gained, limit))
+		_, result.Lost.Cubes = sioCubes(fac, d.vars, bddCubes(fac, lost, limit))
+		result.Gained.Count = new(big.Int).Rsh(gained.ModelCount(), uint(numQuantified)).String()
+		result.Lost.Count = new(big.Int).Rsh(lost.ModelCount(), uint(numQuantified)).String()
+
+		hdl := satHandler(r, cfg.SyncComputationTimout)
+		if result.Gained.Causes, ok = d.violatedBy(r, d.removed, d.added, hdl); !ok {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return
+		}
+		if result.Lost.Causes, ok = d.violatedBy(r, d.added, d.removed, hdl); !ok {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return
+		}
+		if ok = d.backboneChanges(r, &result, olds, news, hdl); !ok {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return
+		}
+		sio.WriteDiffResult(w, r, result)
+	})
+}
+
+// ruleBaseDiff holds the formulas of two rule base versions split into the
+// common, added, and removed formulas.  The indices refer to the formulas
+// and selectors of a single selector solver.
+type ruleBaseDiff struct {
+	fac       formula.Factory
+	formulas  []*formula.StandardProposition
+	common    []int
+	added     []int
+	removed   []int
+	vars      []formula.Variable
+	solver    *sat.Solver
+	selectors []formula.Variable
+}
+
+func newRuleBaseDiff(r *http.Request, fac formula.Factory, olds, news []*formula.StandardProposition) *ruleBaseDiff {
+	d := &ruleBaseDiff{fac: fac}
+	inOld := make(map[formula.Formula]bool, len(olds))
+	for _, p := range olds {
+		inOld[p.Formula()] = true
+	}
+	inNew := make(map[formula.Formula]bool, len(news))
+	for _, p := range news {
+		inNew[p.Formula()] = true
+	}
+	seen := make(map[formula.Formula]bool, len(olds)+len(news))
+	for _, p := range slices.Concat(olds, news) {
+		if seen[p.Formula()] {
+			continue
+		}
+		seen[p.Formula()] = true
+		index := len(d.formulas)
+		switch {
+		case inOld[p.Formula()] && inNew[p.Formula()]:
+			d.common = append(d.common, index)
+		case inNew[p.Formula()]:
+			d.added = append(d.added, index)
+		default:
+			d.removed = append(d.removed, index)
+		}
+		d.formulas = append(d.formulas, p)
+	}
+	fs := make([]formula.Formula, len(d.formulas))
+	for i, p := range d.formulas {
+		fs[i] = p.Formula()
+	}
+	d.vars = sortedByName(fac, formula.Variables(fac, fs...).Content())
+	d.solver, d.selectors = newSelectorSolver(r, fac, fs)
+	return d
+}
+
+// compile returns the BDDs of the old and the new version on a common kernel
+// with all variables of both versions.  The auxiliary variables of constraint
+// encodings are existentially quantified, they remain in the kernel as don't
+// cares and their number is returned as well.
+func (d *ruleBaseDiff) compile(cfg *config.Config) (*bdd.BDD, *bdd.BDD, int, bool) {
+	order := bdd.ForceOrder(d.fac, d.conjunction(d.common, d.added, d.removed))
+	for _, v := range d.vars {
+		if !slices.Contains(order, v) {
+			order = append(order, v)
+		}
+	}
+	numVars := max(int32(len(order)), 1)
+	kernel := bdd.NewKernelWithOrdering(d.fac, order, numVars*30, numVars*20)
+	hdl := bdd.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+	oldBDD, ok := bdd.CompileWithKernelAndHandler(d.fac, d.conjunction(d.common, d.removed), kernel, hdl)
+	if !ok {
+		return nil, nil, 0, false
+	}
+	newBDD, ok := bdd.CompileWithKernelAndHandler(d.fac, d.conjunction(d.common, d.added), kernel, hdl)
+	if !ok {
+		return nil, nil, 0, false
+	}
+	vars := formula.NewVarSet(d.vars...)
+	var quantified []formula.Variable
+	for _, v := range newBDD.VariableOrder() {
+		if !vars.Contains(v) {
+			quantified = append(quantified, v)
+		}
+	}
+	if len(quantified) > 0 {
+		oldBDD, newBDD = oldBDD.Exists(quantified...), newBDD.Exists(quantified...)
+	}
+	return oldBDD, newBDD, len(quantified), true
+}
+
+func (d *ruleBaseDiff) conjunction(indices ...[]int) formula.Formula {
+	var fs []formula.Formula
+	for _, i := range slices.Concat(indices...) {
+		fs = append(fs, d.formulas[i].Formula())
+	}
+	return d.fac.And(fs...)
+}
+
+// violatedBy returns the candidates which are violated by a model of the
+// common and the other formulas.
+func (d *ruleBaseDiff) violatedBy(r *http.Request, candidates, others []int, hdl sat.Handler) ([]sio.Formula, bool) {
+	var violated []int
+	for _, c := range candidates {
+		assumptions := append(d.assumptions(d.common, others), d.selectors[c].Negate(d.fac))
+		result := d.solver.Call(sat.WithAssumptions(assumptions).Handler(hdl))
+		if result.Aborted() {
+			return nil, false
+		}
+		if result.Sat() {
+			violated = append(violated, c)
+		}
+	}
+	return d.sioFormulas(r, violated), true
+}
+
+// backboneChanges computes the backbones of both versions and records the
+// changed literals with their causes and the dead and forced variables.
+func (d *ruleBaseDiff) backboneChanges(
+	r *http.Request,
+	result *sio.DiffResult,
+	olds, news []*formula.StandardProposition,
+	hdl sat.Handler,
+) bool {
+	oldBackbone, ok := d.backbone(olds, hdl)
+	if !ok {
+		return false
+	}
+	newBackbone, ok := d.backbone(news, hdl)
+	if !ok {
+		return false
+	}
+	for _, v := range d.vars {
+		for _, lit := range []formula.Literal{v.AsLiteral(), v.Negate(d.fac)} {
+			var change string
+			var candidates []int
+			switch {
+			case newBackbone[lit] && !oldBackbone[lit]:
+				change, candidates = "added", d.added
+			case oldBackbone[lit] && !newBackbone[lit]:
+				change, candidates = "removed", d.removed
+			default:
+				continue
+			}
+			causes, ok := d.minimalCause(candidates, lit, hdl)
+			if !ok {
+				return false
+			}
+			result.Backbone = append(result.Backbone, sio.BackboneChange{
+				Literal: lit.Sprint(d.fac),
+				Change:  change,
+				Causes:  d.sioFormulas(r, causes),
+			})
+			if change == "added" {
+				name, _ := d.fac.VarName(v)
+				if lit.IsPos() {
+					result.Forced = append(result.Forced, name)
+				} else {
+					result.Dead = append(result.Dead, name)
+				}
+			}
+		}
+	}
+	return true
+}
+
+// backbone returns the backbone literals of the formulas over the variables
+// of both versions.  The backbone of unsatisfiable formulas is empty.
+func (d *ruleBaseDiff) backbone(props []*formula.StandardProposition, hdl sat.Handler) (map[formula.Literal]bool, bool) {
+	solver := sat.NewSolver(d.fac)
+	for _, p := range props {
+		solver.Add(p.Formula())
+	}
+	bb, ok := solver.ComputeBackboneWithHandler(d.fac, d.vars, hdl)
+	if !ok {
+		return nil, false
+	}
+	lits := make(map[formula.Literal]bool)
+	if bb.Sat {
+		for _, v := range bb.Positive {
+			lits[v.AsLiteral()] = true
+		}
+		for _, v := range bb.Negative {
+			lits[v.Negate(d.fac)] = true
+		}
+	}
+	return lits, true
+}
+
+// minimalCause shrinks the candidates to a subset which implies the literal
+// together with the common formulas, but no longer does so as soon as any of
+// its formulas is dropped.
+func (d *ruleBaseDiff) minimalCause(candidates []int, lit formula.Literal, hdl sat.Handler) ([]int, bool) {
+	cause := slices.Clone(candidates)
+	for i := 0; i < len(cause); {
+		candidate := slices.Delete(slices.Clone(cause), i, i+1)
+		assumptions := append(d.assumptions(d.common, candidate), lit.Negate(d.fac))
+		result := d.solver.Call(sat.WithAssumptions(assumptions).Handler(hdl))
+		if result.Aborted() {
+			return nil, false
+		}
+		if result.Sat() {
+			i++
+		} else {
+			cause = candidate
+		}
+	}
+	return cause, true
+}
+
+func (d *ruleBaseDiff) assumptions(indices ...[]int) []formula.Literal {
+	var lits []formula.Literal
+	for _, i := range slices.Concat(indices...) {
+		lits = append(lits, d.selectors[i].AsLiteral())
+	}
+	return lits
+}
+
+func (d *ruleBaseDiff) sioFormulas(r *http.Request, indices []int) []sio.Formula {
+	if len(indices) == 0 {
+		return nil
+	}
+	result := make([]sio.Formula, len(indices))
+	for i, index := range indices {
+		p := d.formulas[index]
+		result[i] = sioFormula(r, d.fac, p.Formula(), p.Description)
+	}
+	return result
+}
@@ -1,6 +1,7 @@
 package computation
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/booleworks/logicng-go/dnnf"
@@ -11,13 +12,20 @@ import (
 )
 
 // @Summary      Compile formulas to DNNF
-// @Description  If a list of formulas is given, the DNNF of the conjunction of these formulas is computed.  The result always contains exactly one formula.
+// @Description  If a list of formulas is given, the DNNF of the conjunction of these formulas is computed.  The result always contains exactly one formula.  With the output 'nnf' the DNNF is written in the NNF format of c2d and d4, where the variable names are given as comment lines 'c <index> <name>'.  With the output 'graph' the DNNF is returned as graph with shared nodes.  NNF files can also be sent as input with the content type 'application/nnf'.
 // @Tags         DNNF
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        output query string  false "Output representation" Enums(formulas, nnf, graph) Default(formulas)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /dnnf/compilation [post]
 func HandleDNNFCompilation(cfg *config.Config) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := r.URL.Query().Get("output")
+		if output != "" && output != "formulas" && output != "nnf" && output != "graph" {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown output '%s'", output)))
+			return
+		}
 		fac := formula.NewFactory()
 		fs, ok := parseFormulaInput(w, r, fac)
 		if !ok {
@@ -27,7 +35,17 @@ func HandleDNNFCompilation(cfg *config.Config) http.Handler {
 		compiled, ok := dnnf.CompileWithHandler(fac, fac.And(fs...), hdl)
 		if !ok {
 			sio.WriteError(w, r, sio.ErrTimeout())
+			return
+		}
+		sio.StatsOf(r).SetDNNF(fac, compiled.Formula)
+		switch output {
+		case "nnf":
+			sio.WriteTextResult(w, r, newNNFWriter(fac, compiled.Formula).c2d())
+		case "graph":
+			nodes, edges := newNNFWriter(fac, compiled.Formula).graph()
+			sio.WriteGraphResult(w, r, nodes, edges)
+		default:
+			sio.WriteFormulaResult(w, r, sioFormula(r, fac, compiled.Formula))
 		}
-		sio.WriteFormulaResult(w, r, sio.Formula{Formula: compiled.Formula.Sprint(fac)})
 	})
 }
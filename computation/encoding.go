@@ -25,14 +25,16 @@ func HandleEncoding(cfg *config.Config) http.Handler {
 }
 
 // @Summary      Encode cardinality constraints to CNF
-// @Description  If a list of formulas is given, the result is computed for each formula independently.
+// @Description  If a list of formulas is given, the result is computed for each formula independently.  With output 'clauses' or 'int-clauses' the clauses are returned as lists of literals in a sio.ClauseResult.
 // @Tags         Encoding
 // @Param        algorithm query string false "Encoding algorithm" Enums(pure, ladder, bimander, commander, nested, binary, product, totalizer, mod_totalizer, cardinality_network)
+// @Param        output query string  false "Output representation" Enums(formulas, clauses, int-clauses) Default(formulas)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /encoding/cc [post]
 func handleEncodingCC(w http.ResponseWriter, r *http.Request) {
-	transformPerFormula(w, r, func(fac formula.Factory, p *formula.StandardProposition) (formula.Formula, sio.ServiceError) {
+	transformPerFormula(w, r, cnfNF, func(fac formula.Factory, p *formula.StandardProposition) (formula.Formula, sio.ServiceError) {
 		form := p.Formula()
 		if form.Sort() != formula.SortCC {
 			return 0, sio.ErrIllegalInput(fmt.Errorf("input '%s' is not a cardinality constraint", form.Sprint(fac)))
@@ -50,14 +52,16 @@ func handleEncodingCC(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary      Encode pseudo-Boolean constraints to CNF
-// @Description  If a list of formulas is given, the result is computed for each formula independently.
+// @Description  If a list of formulas is given, the result is computed for each formula independently.  The constraints can also be given in OPB format (content type 'application/opb').  With output 'clauses' or 'int-clauses' the clauses are returned as lists of literals in a sio.ClauseResult.
 // @Tags         Encoding
 // @Param        algorithm query string false "Encoding algorithm" Enums(swc, binary_merge, adder_networks)
+// @Param        output query string  false "Output representation" Enums(formulas, clauses, int-clauses) Default(formulas)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /encoding/pbc [post]
 func handleEncodingPBC(w http.ResponseWriter, r *http.Request) {
-	transformPerFormula(w, r, func(fac formula.Factory, p *formula.StandardProposition) (formula.Formula, sio.ServiceError) {
+	transformPerFormula(w, r, cnfNF, func(fac formula.Factory, p *formula.StandardProposition) (formula.Formula, sio.ServiceError) {
 		form := p.Formula()
 		if form.Sort() != formula.SortPBC {
 			return 0, sio.ErrIllegalInput(fmt.Errorf("input '%s' is not a pseudo-Boolean constraint", form.Sprint(fac)))
@@ -0,0 +1,417 @@
+package computation
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/handler"
+	"github.com/booleworks/logicng-go/model"
+	"github.com/booleworks/logicng-go/sat"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// pagedEnumeration reports whether a page of models is requested instead of
+// the full enumeration.
+func pagedEnumeration(r *http.Request) bool {
+	return r.URL.Query().Has("limit") || r.URL.Query().Has("cursor")
+}
+
+// enumeratePage writes the next page of at most 'limit' models after the
+// position of the 'cursor' query parameter.  The page ends early if the
+// timeout is reached, the returned cursor continues after the last model of
+// the page in both cases.  If the timeout is reached before the first model,
+// the cursor would not advance, so a timeout error is written instead.  The
+// additional variables are not part of the cursor.
+func enumeratePage(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	additional []formula.Variable,
+	timeout time.Duration,
+) {
+	limit, ok := extractIntParam(w, r, "limit", 0)
+	if !ok {
+		return
+	}
+	if limit < 0 {
+		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("illegal limit value '%d'", limit)))
+		return
+	}
+	vars = sortedByName(fac, vars)
+
+	algorithm := r.URL.Query().Get("algorithm")
+	if algorithm == "" {
+		algorithm = "bdd"
+	}
+	if algorithm != "bdd" && algorithm != "sat" {
+		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown model enumeration algorithm '%s'", algorithm)))
+		return
+	}
+	cursor, err := decodeCursor(r.URL.Query().Get("cursor"), algorithm[0], len(vars))
+	if err != nil {
+		sio.WriteError(w, r, sio.ErrIllegalInput(err))
+		return
+	}
+	var page *modelPage
+	if algorithm == "bdd" {
+		page, ok = enumeratePageBDD(w, r, fac, formulas, vars, additional, cursor, limit, timeout)
+	} else {
+		page = enumeratePageSat(r, fac, formulas, vars, additional, cursor, limit, timeout)
+	}
+	if !ok {
+		return
+	}
+	if page.truncated && len(page.models) == 0 {
+		sio.WriteError(w, r, sio.ErrTimeout())
+		return
+	}
+	models := make([]sio.Formula, len(page.models))
+	for i, m := range page.models {
+		models[i] = sioFormula(r, fac, m.Formula(fac))
+	}
+	nextCursor := ""
+	if page.truncated {
+		nextCursor = page.cursor.encode(len(vars))
+	}
+	sio.WriteModelPageResult(w, r, models, nextCursor, page.count)
+}
+
+// additionalVariables returns the distinct additional variables which are not
+// enumeration variables.
+func additionalVariables(fac formula.Factory, names []string, vars []formula.Variable) []formula.Variable {
+	projection := formula.NewVarSet(vars...)
+	var result []formula.Variable
+	for _, name := range names {
+		if v := fac.Var(name); !projection.Contains(v) && !slices.Contains(result, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// sortedByName returns the distinct variables sorted by their names.
+func sortedByName(fac formula.Factory, vars []formula.Variable) []formula.Variable {
+	result := formula.NewVarSet(vars...).Content()
+	slices.SortFunc(result, func(a, b formula.Variable) int {
+		nameA, _ := fac.VarName(a)
+		nameB, _ := fac.VarName(b)
+		return strings.Compare(nameA, nameB)
+	})
+	return result
+}
+
+type modelPage struct {
+	models    []*model.Model
+	truncated bool
+	cursor    *enumerationCursor
+	count     string
+}
+
+// enumerationCursor records the position of an enumeration by the last
+// returned model.  Both algorithms enumerate the models in a fixed
+// lexicographic order, so the next page starts after this model.  The model is
+// stored as phases of the variables sorted by name, it is nil at the start.
+type enumerationCursor struct {
+	algorithm byte
+	last      []bool
+}
+
+func (c *enumerationCursor) encode(numVars int) string {
+	data := []byte{c.algorithm}
+	data = binary.AppendUvarint(data, uint64(numVars))
+	if c.last == nil {
+		data = append(data, 0)
+	} else {
+		data = append(data, 1)
+		bits := make([]byte, (numVars+7)/8)
+		for i, phase := range c.last {
+			if phase {
+				bits[i/8] |= 1 << (i % 8)
+			}
+		}
+		data = append(data, bits...)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string, algorithm byte, numVars int) (*enumerationCursor, error) {
+	result := &enumerationCursor{algorithm: algorithm}
+	if cursor == "" {
+		return result, nil
+	}
+	illegal := errors.New("illegal cursor for this enumeration")
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(data) == 0 || data[0] != algorithm {
+		return nil, illegal
+	}
+	data = data[1:]
+	n, read := binary.Uvarint(data)
+	if read <= 0 || n != uint64(numVars) {
+		return nil, illegal
+	}
+	data = data[read:]
+	switch {
+	case len(data) == 1 && data[0] == 0:
+	case len(data) == 1+(numVars+7)/8 && data[0] == 1:
+		result.last = make([]bool, numVars)
+		for i := range result.last {
+			result.last[i] = data[1+i/8]&(1<<(i%8)) != 0
+		}
+	default:
+		return nil, illegal
+	}
+	return result, nil
+}
+
+func modelFromPhases(fac formula.Factory, vars []formula.Variable, phases []bool) *model.Model {
+	literals := make([]formula.Literal, len(vars))
+	for i, v := range vars {
+		literals[i] = v.AsLiteral()
+		if !phases[i] {
+			literals[i] = v.Negate(fac)
+		}
+	}
+	return model.New(literals...)
+}
+
+// enumeratePageBDD enumerates the paths of the projected BDD in lexicographic
+// order of the BDD's variable order, negative phases first.  Paths before the
+// cursor model are skipped without enumerating their models.
+func enumeratePageBDD(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	additional []formula.Variable,
+	cursor *enumerationCursor,
+	limit int,
+	timeout time.Duration,
+) (*modelPage, bool) {
+	deadline := handler.NewTimeoutWithDuration(timeout)
+	completion, numQuantified, ok := compileProjectedBDD(w, r, fac, formulas, append(slices.Clone(vars), additional...), timeout)
+	if !ok {
+		return nil, false
+	}
+	projected := completion
+	if len(additional) > 0 {
+		projected = completion.Exists(additional...)
+	}
+	count := projected.ModelCount()
+	count.Rsh(count, uint(numQuantified+len(additional)))
+	page := &modelPage{cursor: cursor, count: count.String()}
+	serialized := serializeBDD(fac, projected)
+
+	// positions maps the levels of projection variables to their index in vars
+	index := make(map[string]int, len(vars))
+	for i, v := range vars {
+		name, _ := fac.VarName(v)
+		index[name] = i
+	}
+	var positions []int
+	levels := make(map[string]int)
+	for _, name := range serialized.Order {
+		if i, ok := index[name]; ok {
+			levels[name] = len(positions)
+			positions = append(positions, i)
+		}
+	}
+	nodes := make(map[int32]sio.BDDNode, len(serialized.Nodes))
+	for _, n := range serialized.Nodes {
+		nodes[n.ID] = n
+	}
+	nodeLevel := func(id int32) int {
+		if id <= 1 {
+			return len(positions)
+		}
+		return levels[nodes[id].Variable]
+	}
+
+	after := cursor.last
+	phases := make([]bool, len(vars))
+	var walk func(id int32, level int, tight bool) bool
+	walk = func(id int32, level int, tight bool) bool {
+		if id == 0 {
+			return false
+		}
+		if level == len(positions) {
+			if tight {
+				return false
+			}
+			if limit > 0 && len(page.models) == limit || deadline.TimeLimitExceeded() {
+				page.truncated = true
+				return true
+			}
+			m := modelFromPhases(fac, vars, phases)
+			completeModel(completion, m, additional)
+			page.models = append(page.models, m)
+			page.cursor = &enumerationCursor{cursor.algorithm, slices.Clone(phases)}
+			return false
+		}
+		pos := positions[level]
+		for _, phase := range []bool{false, true} {
+			if tight && !phase && after[pos] {
+				continue
+			}
+			child := id
+			if nodeLevel(id) == level {
+				child = nodes[id].Low
+				if phase {
+					child = nodes[id].High
+				}
+			}
+			phases[pos] = phase
+			if walk(child, level+1, tight && phase == after[pos]) {
+				return true
+			}
+		}
+		return false
+	}
+	walk(serialized.Root, 0, after != nil)
+	return page, true
+}
+
+// enumeratePageSat enumerates the models with a SAT solver in lexicographic
+// order of the variables sorted by name, negative phases first, like the BDD
+// enumeration.  Each model is found under assumptions on one incremental
+// solver, so the cursor only holds the last model.
+func enumeratePageSat(
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	additional []formula.Variable,
+	cursor *enumerationCursor,
+	limit int,
+	timeout time.Duration,
+) *modelPage {
+	solver := sat.NewSolver(fac)
+	solver.Add(formulas...)
+	e := &lexSatEnumeration{
+		fac:       fac,
+		solver:    solver,
+		vars:      vars,
+		modelVars: append(slices.Clone(vars), additional...),
+		hdl:       satHandler(r, timeout),
+	}
+
+	page := &modelPage{cursor: cursor}
+	for {
+		m, found, ok := e.next(page.cursor.last)
+		if !ok || found && limit > 0 && len(page.models) == limit {
+			page.truncated = true
+			return page
+		}
+		if !found {
+			return page
+		}
+		positive := formula.NewVarSet(m.PosVars()...)
+		phases := make([]bool, len(vars))
+		for i, v := range vars {
+			phases[i] = positive.Contains(v)
+		}
+		pageModel := modelFromPhases(fac, vars, phases)
+		for _, v := range additional {
+			if positive.Contains(v) {
+				pageModel.AddLiteral(v.AsLiteral())
+			} else {
+				pageModel.AddLiteral(v.Negate(fac))
+			}
+		}
+		page.models = append(page.models, pageModel)
+		page.cursor = &enumerationCursor{cursor.algorithm, phases}
+	}
+}
+
+// lexSatEnumeration finds the models of a solver in lexicographic order of
+// the variables, negative phases first.  All methods return whether a model
+// was found and false as last value if the solver was aborted.
+type lexSatEnumeration struct {
+	fac       formula.Factory
+	solver    *sat.Solver
+	vars      []formula.Variable
+	modelVars []formula.Variable
+	hdl       sat.Handler
+}
+
+// next returns the smallest model after the given phases, or the smallest
+// model at all if there are no phases.  The successor keeps the longest
+// prefix of the phases which can be followed by a positive instead of a
+// negative phase.
+func (e *lexSatEnumeration) next(after []bool) (*model.Model, bool, bool) {
+	if after == nil {
+		return e.smallest(nil, nil)
+	}
+	for i := len(e.vars) - 1; i >= 0; i-- {
+		if after[i] {
+			continue
+		}
+		prefix := make([]formula.Literal, i+1)
+		for j, phase := range after[:i] {
+			prefix[j] = e.vars[j].AsLiteral()
+			if !phase {
+				prefix[j] = e.vars[j].Negate(e.fac)
+			}
+		}
+		prefix[i] = e.vars[i].AsLiteral()
+		witness, found, ok := e.solve(prefix)
+		if !ok {
+			return nil, false, false
+		}
+		if found {
+			return e.smallest(prefix, witness)
+		}
+	}
+	return nil, false, true
+}
+
+// smallest completes the prefix to the smallest model.  A given witness is a
+// model of the prefix.  Phases which are negative in the witness are kept,
+// for positive phases the solver checks whether a negative phase is possible.
+func (e *lexSatEnumeration) smallest(prefix []formula.Literal, witness *model.Model) (*model.Model, bool, bool) {
+	if witness == nil {
+		var found, ok bool
+		if witness, found, ok = e.solve(prefix); !found || !ok {
+			return nil, found, ok
+		}
+	}
+	positive := formula.NewVarSet(witness.PosVars()...)
+	for _, v := range e.vars[len(prefix):] {
+		if !positive.Contains(v) {
+			prefix = append(prefix, v.Negate(e.fac))
+			continue
+		}
+		candidate := append(slices.Clone(prefix), v.Negate(e.fac))
+		m, found, ok := e.solve(candidate)
+		if !ok {
+			return nil, false, false
+		}
+		if found {
+			prefix, witness = candidate, m
+			positive = formula.NewVarSet(witness.PosVars()...)
+		} else {
+			prefix = append(prefix, v.AsLiteral())
+		}
+	}
+	return witness, true, true
+}
+
+func (e *lexSatEnumeration) solve(assumptions []formula.Literal) (*model.Model, bool, bool) {
+	result := e.solver.Call(sat.WithAssumptions(assumptions).WithModel(e.modelVars).Handler(e.hdl))
+	if result.Aborted() {
+		return nil, false, false
+	}
+	if !result.Sat() {
+		return nil, false, true
+	}
+	return result.Model(), true, true
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/booleworks/logicng-go/explanation/mus"
 	"github.com/booleworks/logicng-go/explanation/smus"
 	"github.com/booleworks/logicng-go/formula"
-	"github.com/booleworks/logicng-go/handler"
 	"github.com/booleworks/logicng-go/sat"
 	"github.com/booleworks/logicng-service/config"
 	"github.com/booleworks/logicng-service/sio"
@@ -18,6 +17,7 @@ import (
 // @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.
 // @Tags         Explanation
 // @Param        algorithm query string  false "MUS Algorithm" Enums(deletion, insertion) Default(deletion)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Formula input"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /explanation/mus [post]
@@ -34,7 +34,7 @@ func HandleMUS(cfg *config.Config) http.Handler {
 			props[i] = p
 		}
 
-		hdl := sat.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+		hdl := satHandler(r, cfg.SyncComputationTimout)
 		var core *explanation.UnsatCore
 		var err error
 		switch algorithm {
@@ -55,7 +55,7 @@ func HandleMUS(cfg *config.Config) http.Handler {
 		result := make([]sio.Formula, len(core.Propositions))
 		for i, p := range core.Propositions {
 			prop := p.(*formula.StandardProposition)
-			result[i] = sio.Formula{Formula: p.Formula().Sprint(fac), Description: prop.Description}
+			result[i] = sioFormula(r, fac, p.Formula(), prop.Description)
 		}
 		sio.WriteFormulaResult(w, r, result...)
 	})
@@ -64,6 +64,7 @@ func HandleMUS(cfg *config.Config) http.Handler {
 // @Summary      Compute a shortest minimal unsatisfiable set (SMUS) of an unsatisfiable formula
 // @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.
 // @Tags         Explanation
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Formula input"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /explanation/smus [post]
@@ -79,7 +80,7 @@ func HandleSMUS(cfg *config.Config) http.Handler {
 			props[i] = p
 		}
 
-		hdl := sat.OptimizationHandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+		hdl := optimizationHandler(r, cfg.SyncComputationTimout)
 		res, ok := smus.ComputeWithHandler(fac, props, hdl)
 		if len(res) == 0 {
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("bad input: formula set is satisfiable")))
@@ -91,7 +92,70 @@ func HandleSMUS(cfg *config.Config) http.Handler {
 		result := make([]sio.Formula, len(res))
 		for i, p := range res {
 			prop := p.(*formula.StandardProposition)
-			result[i] = sio.Formula{Formula: p.Formula().Sprint(fac), Description: prop.Description}
+			result[i] = sioFormula(r, fac, p.Formula(), prop.Description)
+		}
+		sio.WriteFormulaResult(w, r, result...)
+	})
+}
+
+// @Summary      Compute the redundant formulas of a rule base
+// @Description  A formula is redundant if it is implied by the other formulas.  With mode 'redundant' all formulas which are implied by the rest are returned.  With mode 'irredundant' a minimal subset of the formulas which is equivalent to all formulas is returned: the formulas are checked in their input order and each redundant formula is dropped before the next is checked.  The formulas are identified by their descriptions.
+// @Tags         Explanation
+// @Param        mode query string  false "Redundancy mode" Enums(redundant, irredundant) Default(redundant)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        request body	sio.FormulaInput true "Formula input"
+// @Success      200  {object}  sio.FormulaResult
+// @Router       /explanation/redundancy [post]
+func HandleRedundancy(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mode := r.URL.Query().Get("mode")
+		if mode != "" && mode != "redundant" && mode != "irredundant" {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown redundancy mode '%s'", mode)))
+			return
+		}
+		fac := formula.NewFactory()
+		ps, ok := parsePropInput(w, r, fac)
+		if !ok {
+			return
+		}
+		fs := make([]formula.Formula, len(ps))
+		for i, p := range ps {
+			fs[i] = p.Formula()
+		}
+		solver, selectors := newSelectorSolver(r, fac, fs)
+
+		// A formula is implied by the active formulas if they are
+		// unsatisfiable together with its negation.
+		active := make([]bool, len(ps))
+		for i := range active {
+			active[i] = true
+		}
+		hdl := satHandler(r, cfg.SyncComputationTimout)
+		result := make([]sio.Formula, 0, len(ps))
+		for i, p := range ps {
+			assumptions := make([]formula.Literal, 0, len(ps))
+			for j, sel := range selectors {
+				if j == i {
+					assumptions = append(assumptions, sel.Negate(fac))
+				} else if active[j] {
+					assumptions = append(assumptions, sel.AsLiteral())
+				}
+			}
+			call := solver.Call(sat.WithAssumptions(assumptions).Handler(hdl))
+			if call.Aborted() {
+				sio.WriteError(w, r, sio.ErrTimeout())
+				return
+			}
+			redundant := !call.Sat()
+			if mode == "irredundant" {
+				if redundant {
+					active[i] = false
+				} else {
+					result = append(result, sioFormula(r, fac, p.Formula(), p.Description))
+				}
+			} else if redundant {
+				result = append(result, sioFormula(r, fac, p.Formula(), p.Description))
+			}
 		}
 		sio.WriteFormulaResult(w, r, result...)
 	})
@@ -0,0 +1,388 @@
+package computation
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/booleworks/logicng-go/bdd"
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-service/config"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+var (
+	smtSimpleSymbol = regexp.MustCompile(`^[A-Za-z~!$%^&*_+=<>.?/-][A-Za-z0-9~!@$%^&*_+=<>.?/-]*$`)
+	tptpLowerWord   = regexp.MustCompile(`^[a-z][A-Za-z0-9_]*$`)
+)
+
+var unicodeSymbols = &formula.PrintSymbols{
+	Verum:          "⊤",
+	Falsum:         "⊥",
+	Not:            "¬",
+	Implication:    " → ",
+	Equivalence:    " ↔ ",
+	And:            " ∧ ",
+	Or:             " ∨ ",
+	LeftBracket:    "(",
+	RightBracket:   ")",
+	Plus:           " + ",
+	Minus:          "-",
+	Multiplication: "·",
+	Equal:          " = ",
+	Less:           " < ",
+	LessOrEqual:    " ≤ ",
+	Greater:        " > ",
+	GreaterOrEqual: " ≥ ",
+}
+
+var latexSymbols = &formula.PrintSymbols{
+	Verum:          "\\top",
+	Falsum:         "\\bot",
+	Not:            "\\lnot ",
+	Implication:    " \\rightarrow ",
+	Equivalence:    " \\leftrightarrow ",
+	And:            " \\land ",
+	Or:             " \\lor ",
+	LeftBracket:    "(",
+	RightBracket:   ")",
+	Plus:           " + ",
+	Minus:          "-",
+	Multiplication: " \\cdot ",
+	Equal:          " = ",
+	Less:           " < ",
+	LessOrEqual:    " \\leq ",
+	Greater:        " > ",
+	GreaterOrEqual: " \\geq ",
+}
+
+var tptpSymbols = &formula.PrintSymbols{
+	Verum:        "$true",
+	Falsum:       "$false",
+	Not:          "~ ",
+	Implication:  " => ",
+	Equivalence:  " <=> ",
+	And:          " & ",
+	Or:           " | ",
+	LeftBracket:  "(",
+	RightBracket: ")",
+}
+
+var smtComparators = map[formula.CSort]string{
+	formula.EQ: "=",
+	formula.LE: "<=",
+	formula.LT: "<",
+	formula.GE: ">=",
+	formula.GT: ">",
+}
+
+func HandleFormulaExport(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch format := r.PathValue("format"); format {
+		case "smtlib2":
+			handleExportSMTLib2(w, r)
+		case "tptp":
+			handleExportTPTP(w, r)
+		case "latex":
+			handleExportLaTeX(w, r)
+		case "unicode":
+			handleExportUnicode(w, r)
+		default:
+			sio.WriteError(w, r, sio.ErrUnknownPath(r.URL.Path))
+		}
+	})
+}
+
+// @Summary      Export formulas as SMT-LIB2 script
+// @Description  Each variable is declared as Boolean constant and each formula is asserted.  Formula descriptions are added as ':named' attributes.  Cardinality and pseudo-Boolean constraints are translated to linear integer arithmetic.
+// @Tags         Formula
+// @Param        request body	sio.FormulaInput true "Input formulas"
+// @Success      200  {string}  script string
+// @Router       /formula/export/smtlib2 [post]
+func handleExportSMTLib2(w http.ResponseWriter, r *http.Request) {
+	fac := formula.NewFactory()
+	ps, ok := parsePropInput(w, r, fac)
+	if !ok {
+		return
+	}
+	fs := make([]formula.Formula, len(ps))
+	logic := "QF_UF"
+	for i, p := range ps {
+		fs[i] = p.Formula()
+		for _, sub := range formula.SubNodes(fac, p.Formula()) {
+			if sub.Sort() == formula.SortCC || sub.Sort() == formula.SortPBC {
+				logic = "QF_LIA"
+			}
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("(set-logic %s)\n", logic))
+	for _, v := range formula.Variables(fac, fs...).Content() {
+		name, _ := fac.VarName(v)
+		sb.WriteString(fmt.Sprintf("(declare-const %s Bool)\n", smtSymbol(name)))
+	}
+	for _, p := range ps {
+		assertion := smtFormula(fac, p.Formula())
+		if p.Description != "" {
+			assertion = fmt.Sprintf("(! %s :named %s)", assertion, smtSymbol(p.Description))
+		}
+		sb.WriteString(fmt.Sprintf("(assert %s)\n", assertion))
+	}
+	sio.WriteStringResultAsText(w, r, sb.String())
+}
+
+// @Summary      Export formulas as TPTP FOF problem
+// @Description  Each formula is exported as axiom, named by its description if present.  Cardinality and pseudo-Boolean constraints are translated to an equivalent CNF without auxiliary variables.
+// @Tags         Formula
+// @Param        request body	sio.FormulaInput true "Input formulas"
+// @Success      200  {string}  problem string
+// @Router       /formula/export/tptp [post]
+func handleExportTPTP(w http.ResponseWriter, r *http.Request) {
+	fac := formula.NewFactory()
+	ps, ok := parsePropInput(w, r, fac)
+	if !ok {
+		return
+	}
+	printer := &infixPrinter{fac, tptpSymbols, tptpName, true}
+	var sb strings.Builder
+	for i, p := range ps {
+		name := fmt.Sprintf("f%d", i+1)
+		if p.Description != "" {
+			name = tptpName(p.Description)
+		}
+		f := printer.print(expandPBCs(fac, p.Formula()))
+		sb.WriteString(fmt.Sprintf("fof(%s, axiom, %s).\n", name, f))
+	}
+	sio.WriteStringResultAsText(w, r, sb.String())
+}
+
+// @Summary      Export formulas as LaTeX math
+// @Description  Each formula is written on its own line without math delimiters.
+// @Tags         Formula
+// @Param        request body	sio.FormulaInput true "Input formulas"
+// @Success      200  {string}  latex string
+// @Router       /formula/export/latex [post]
+func handleExportLaTeX(w http.ResponseWriter, r *http.Request) {
+	exportInfix(w, r, latexSymbols, latexName)
+}
+
+// @Summary      Export formulas as Unicode pretty print
+// @Description  Each formula is written on its own line.
+// @Tags         Formula
+// @Param        request body	sio.FormulaInput true "Input formulas"
+// @Success      200  {string}  unicode string
+// @Router       /formula/export/unicode [post]
+func handleExportUnicode(w http.ResponseWriter, r *http.Request) {
+	exportInfix(w, r, unicodeSymbols, func(name string) string { return name })
+}
+
+func exportInfix(w http.ResponseWriter, r *http.Request, s *formula.PrintSymbols, name func(string) string) {
+	fac := formula.NewFactory()
+	fs, ok := parseFormulaInput(w, r, fac)
+	if !ok {
+		return
+	}
+	printer := &infixPrinter{fac, s, name, false}
+	var sb strings.Builder
+	for _, f := range fs {
+		sb.WriteString(printer.print(f))
+		sb.WriteString("\n")
+	}
+	sio.WriteStringResultAsText(w, r, sb.String())
+}
+
+func smtFormula(fac formula.Factory, f formula.Formula) string {
+	switch fsort := f.Sort(); fsort {
+	case formula.SortTrue:
+		return "true"
+	case formula.SortFalse:
+		return "false"
+	case formula.SortLiteral:
+		name, phase, _ := fac.LiteralNamePhase(f)
+		if phase {
+			return smtSymbol(name)
+		}
+		return fmt.Sprintf("(not %s)", smtSymbol(name))
+	case formula.SortNot:
+		op, _ := fac.NotOperand(f)
+		return fmt.Sprintf("(not %s)", smtFormula(fac, op))
+	case formula.SortImpl, formula.SortEquiv:
+		left, right, _ := fac.BinaryLeftRight(f)
+		op := "=>"
+		if fsort == formula.SortEquiv {
+			op = "="
+		}
+		return fmt.Sprintf("(%s %s %s)", op, smtFormula(fac, left), smtFormula(fac, right))
+	case formula.SortAnd, formula.SortOr:
+		ops, _ := fac.NaryOperands(f)
+		op := "and"
+		if fsort == formula.SortOr {
+			op = "or"
+		}
+		operands := make([]string, len(ops))
+		for i, o := range ops {
+			operands[i] = smtFormula(fac, o)
+		}
+		return fmt.Sprintf("(%s %s)", op, strings.Join(operands, " "))
+	default:
+		comparator, rhs, lits, coeffs, _ := fac.PBCOps(f)
+		summands := make([]string, len(lits))
+		for i, l := range lits {
+			name, phase, _ := fac.LitNamePhase(l)
+			term := fmt.Sprintf("(ite %s 1 0)", smtSymbol(name))
+			if !phase {
+				term = fmt.Sprintf("(ite %s 0 1)", smtSymbol(name))
+			}
+			if coeffs[i] != 1 {
+				term = fmt.Sprintf("(* %s %s)", smtInt(coeffs[i]), term)
+			}
+			summands[i] = term
+		}
+		sum := "0"
+		if len(summands) == 1 {
+			sum = summands[0]
+		} else if len(summands) > 1 {
+			sum = fmt.Sprintf("(+ %s)", strings.Join(summands, " "))
+		}
+		return fmt.Sprintf("(%s %s %s)", smtComparators[comparator], sum, smtInt(rhs))
+	}
+}
+
+func smtInt(value int) string {
+	if value < 0 {
+		return fmt.Sprintf("(- %d)", -value)
+	}
+	return fmt.Sprintf("%d", value)
+}
+
+func smtSymbol(name string) string {
+	if smtSimpleSymbol.MatchString(name) {
+		return name
+	}
+	return "|" + strings.NewReplacer("|", "", "\\", "").Replace(name) + "|"
+}
+
+func tptpName(name string) string {
+	if tptpLowerWord.MatchString(name) {
+		return name
+	}
+	return "'" + strings.NewReplacer("\\", "\\\\", "'", "\\'").Replace(name) + "'"
+}
+
+func latexName(name string) string {
+	escaped := strings.NewReplacer("_", "\\_", "#", "\\#", "$", "\\$", "%", "\\%", "&", "\\&").Replace(name)
+	if len([]rune(name)) == 1 {
+		return escaped
+	}
+	return "\\mathit{" + escaped + "}"
+}
+
+// expandPBCs replaces all cardinality and pseudo-Boolean constraints of a
+// formula by an equivalent CNF computed via a BDD.
+func expandPBCs(fac formula.Factory, f formula.Formula) formula.Formula {
+	switch fsort := f.Sort(); fsort {
+	case formula.SortCC, formula.SortPBC:
+		return bdd.CNF(fac, f)
+	case formula.SortNot:
+		op, _ := fac.NotOperand(f)
+		return fac.Not(expandPBCs(fac, op))
+	case formula.SortImpl, formula.SortEquiv:
+		left, right, _ := fac.BinaryLeftRight(f)
+		result, _ := fac.BinaryOperator(fsort, expandPBCs(fac, left), expandPBCs(fac, right))
+		return result
+	case formula.SortAnd, formula.SortOr:
+		ops, _ := fac.NaryOperands(f)
+		expanded := make([]formula.Formula, len(ops))
+		for i, op := range ops {
+			expanded[i] = expandPBCs(fac, op)
+		}
+		result, _ := fac.NaryOperator(fsort, expanded...)
+		return result
+	default:
+		return f
+	}
+}
+
+// infixPrinter prints formulas in infix notation with the given symbols.  In
+// contrast to the factory's printer, variable names are passed through the
+// name function and operands of binary and n-ary operators can be bracketed
+// strictly as required by TPTP.
+type infixPrinter struct {
+	fac     formula.Factory
+	symbols *formula.PrintSymbols
+	name    func(string) string
+	strict  bool
+}
+
+func (p *infixPrinter) print(f formula.Formula) string {
+	s := p.symbols
+	switch fsort := f.Sort(); fsort {
+	case formula.SortTrue:
+		return s.Verum
+	case formula.SortFalse:
+		return s.Falsum
+	case formula.SortLiteral:
+		return p.literal(f)
+	case formula.SortNot:
+		op, _ := p.fac.NotOperand(f)
+		return s.Not + s.LeftBracket + p.print(op) + s.RightBracket
+	case formula.SortImpl, formula.SortEquiv:
+		left, right, _ := p.fac.BinaryLeftRight(f)
+		op := s.Implication
+		if fsort == formula.SortEquiv {
+			op = s.Equivalence
+		}
+		return p.operand(fsort, left) + op + p.operand(fsort, right)
+	case formula.SortAnd, formula.SortOr:
+		ops, _ := p.fac.NaryOperands(f)
+		op := s.And
+		if fsort == formula.SortOr {
+			op = s.Or
+		}
+		operands := make([]string, len(ops))
+		for i, o := range ops {
+			operands[i] = p.operand(fsort, o)
+		}
+		return strings.Join(operands, op)
+	default:
+		comparator, rhs, lits, coeffs, _ := p.fac.PBCOps(f)
+		summands := make([]string, len(lits))
+		for i, l := range lits {
+			summands[i] = p.literal(formula.Formula(l))
+			if coeffs[i] != 1 {
+				summands[i] = fmt.Sprintf("%d%s%s", coeffs[i], s.Multiplication, summands[i])
+			}
+		}
+		var cmp string
+		switch comparator {
+		case formula.EQ:
+			cmp = s.Equal
+		case formula.LE:
+			cmp = s.LessOrEqual
+		case formula.LT:
+			cmp = s.Less
+		case formula.GE:
+			cmp = s.GreaterOrEqual
+		default:
+			cmp = s.Greater
+		}
+		return fmt.Sprintf("%s%s%d", strings.Join(summands, s.Plus), cmp, rhs)
+	}
+}
+
+func (p *infixPrinter) literal(f formula.Formula) string {
+	name, phase, _ := p.fac.LiteralNamePhase(f)
+	if phase {
+		return p.name(name)
+	}
+	return p.symbols.Not + p.name(name)
+}
+
+func (p *infixPrinter) operand(parent formula.FSort, op formula.Formula) string {
+	if op.Sort() < formula.SortAnd || !p.strict && parent > op.Sort() {
+		return p.print(op)
+	}
+	return p.symbols.LeftBracket + p.print(op) + p.symbols.RightBracket
+}
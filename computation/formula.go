@@ -125,6 +125,7 @@ func handleFormulaLiterals(w http.ResponseWriter, r *http.Request) {
 // @Summary      Compute all sub-formulas of a formula
 // @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.
 // @Tags         Formula
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /formula/sub-formulas [post]
@@ -137,7 +138,7 @@ func handleFormulaSubFormulas(w http.ResponseWriter, r *http.Request) {
 	sf := formula.SubNodes(fac, fac.And(fs...))
 	result := make([]sio.Formula, len(sf))
 	for i, l := range sf {
-		result[i] = sio.Formula{Formula: l.Sprint(fac)}
+		result[i] = sioFormula(r, fac, l)
 	}
 	sio.WriteFormulaResult(w, r, result...)
 }
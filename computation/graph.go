@@ -76,6 +76,7 @@ func HandleConstraintGraphGraphical(cfg *config.Config) http.Handler {
 // @Summary      Compute clusters of formulas which occurr in the same components of the constraint graph
 // @Description  Takes a list of formulas. Each node represents a variable.  Two nodes are connected if the respective variables occurr in the same formula.
 // @Tags         Graph
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.ComponentResult
 // @Router       /graph/components [post]
@@ -100,7 +101,7 @@ func HandleGraphComponents(cfg *config.Config) http.Handler {
 		for i, c := range clusters {
 			result[i] = make([]sio.Formula, len(c))
 			for j, f := range c {
-				result[i][j] = sio.Formula{Formula: f.Sprint(fac), Description: pMap[f]}
+				result[i][j] = sioFormula(r, fac, f, pMap[f])
 			}
 		}
 		sio.WriteComponentResult(w, r, result)
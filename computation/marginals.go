@@ -0,0 +1,152 @@
+package computation
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-service/config"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// @Summary      Compute the marginals of variables
+// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.  For each variable (all projection variables if no variables are given) the number of models in which the variable is true and its ratio to all models is computed.  The models are projected to the projection variables or range over all variables of the formulas.  All marginals are computed in one pass over a BDD.
+// @Tags         Model
+// @Param        request body	sio.MarginalsInput true "Formulas, variables, and optional projection"
+// @Success      200  {object}  sio.MarginalsResult
+// @Router       /model/marginals [post]
+func HandleMarginals(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fac := formula.NewFactory()
+		input, err := sio.Unmarshal[sio.MarginalsInput](r)
+		if err != nil {
+			sio.WriteError(w, r, err)
+			return
+		}
+		useSolverConfig(fac, input.SolverConfig)
+		formulas, ok := parseFormulas(w, r, fac, input.Formulas)
+		if !ok {
+			return
+		}
+		var projection *formula.VarSet
+		if len(input.Projection) > 0 {
+			projection = formula.NewVarSet(varsFromNames(fac, input.Projection)...)
+		} else {
+			vars := formula.NewMutableVarSetCopy(formula.Variables(fac, formulas...))
+			vars.AddAll(formula.NewVarSet(varsFromNames(fac, input.Variables)...))
+			projection = vars.AsImmutable()
+		}
+		names := input.Variables
+		if len(names) == 0 {
+			for _, v := range projection.Content() {
+				name, _ := fac.VarName(v)
+				names = append(names, name)
+			}
+			slices.Sort(names)
+		}
+		for _, name := range names {
+			if !projection.Contains(fac.Var(name)) {
+				sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("variable '%s' is not in the projection", name)))
+				return
+			}
+		}
+
+		projected, _, ok := compileProjectedBDD(w, r, fac, formulas, projection.Content(), cfg.SyncComputationTimout)
+		if !ok {
+			return
+		}
+		count, trueCounts := bddMarginals(fac, serializeBDD(fac, projected), projection)
+		total := new(big.Float).SetInt(count)
+		marginals := make([]sio.Marginal, len(names))
+		for i, name := range names {
+			trueCount := trueCounts[name]
+			var ratio float64
+			if count.Sign() > 0 {
+				ratio, _ = new(big.Float).Quo(new(big.Float).SetInt(trueCount), total).Float64()
+			}
+			marginals[i] = sio.Marginal{Variable: name, Count: trueCount.String(), Ratio: ratio}
+		}
+		sio.WriteMarginalsResult(w, r, count.String(), marginals)
+	})
+}
+
+// bddMarginals computes the model count of a serialised BDD and for each
+// variable the number of models in which it is true.  Only the projection
+// variables count as don't cares on paths which skip them.  The count of each
+// node's sub-BDD is computed bottom-up, the number of paths reaching each node
+// top-down.  Edges skipping a level contribute half of their models to the
+// level's variable, which is accumulated in a difference array.
+func bddMarginals(fac formula.Factory, b *sio.BDD, projection *formula.VarSet) (*big.Int, map[string]*big.Int) {
+	numLevels := len(b.Order)
+	levels := make(map[string]int, numLevels)
+	// projected[i] is the number of projection variables on the levels before i
+	projected := make([]uint, numLevels+1)
+	for i, name := range b.Order {
+		levels[name] = i
+		projected[i+1] = projected[i]
+		if projection.Contains(fac.Var(name)) {
+			projected[i+1]++
+		}
+	}
+	skip := func(from, to int) *big.Int {
+		return new(big.Int).Lsh(big.NewInt(1), projected[to]-projected[from])
+	}
+	nodeLevel := map[int32]int{0: numLevels, 1: numLevels}
+	down := map[int32]*big.Int{0: big.NewInt(0), 1: big.NewInt(1)}
+	for _, n := range b.Nodes {
+		level := levels[n.Variable]
+		nodeLevel[n.ID] = level
+		low := new(big.Int).Mul(down[n.Low], skip(level+1, nodeLevel[n.Low]))
+		high := new(big.Int).Mul(down[n.High], skip(level+1, nodeLevel[n.High]))
+		down[n.ID] = low.Add(low, high)
+	}
+
+	rootLevel := nodeLevel[b.Root]
+	count := new(big.Int).Mul(down[b.Root], skip(0, rootLevel))
+	diff := make([]*big.Int, numLevels+1)
+	for i := range diff {
+		diff[i] = new(big.Int)
+	}
+	addSkipped := func(from, to int, models *big.Int) {
+		half := new(big.Int).Rsh(models, 1)
+		diff[from].Add(diff[from], half)
+		diff[to].Sub(diff[to], half)
+	}
+	addSkipped(0, rootLevel, count)
+
+	trueCounts := make(map[string]*big.Int, numLevels)
+	for _, name := range b.Order {
+		trueCounts[name] = new(big.Int)
+	}
+	up := map[int32]*big.Int{b.Root: skip(0, rootLevel)}
+	for i := len(b.Nodes) - 1; i >= 0; i-- {
+		n := b.Nodes[i]
+		level := nodeLevel[n.ID]
+		paths, ok := up[n.ID]
+		if !ok {
+			continue
+		}
+		for _, child := range []int32{n.Low, n.High} {
+			edge := new(big.Int).Mul(paths, skip(level+1, nodeLevel[child]))
+			if _, ok := up[child]; !ok {
+				up[child] = new(big.Int)
+			}
+			up[child].Add(up[child], edge)
+			models := new(big.Int).Mul(edge, down[child])
+			addSkipped(level+1, nodeLevel[child], models)
+			if child == n.High {
+				trueCounts[n.Variable].Add(trueCounts[n.Variable], models)
+			}
+		}
+	}
+	sum := new(big.Int)
+	for i, name := range b.Order {
+		sum.Add(sum, diff[i])
+		if projection.Contains(fac.Var(name)) {
+			trueCounts[name].Add(trueCounts[name], sum)
+		}
+	}
+	return count, trueCounts
+}
@@ -3,15 +3,18 @@ package computation
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/booleworks/logicng-go/formula"
-	"github.com/booleworks/logicng-go/handler"
 	"github.com/booleworks/logicng-go/maxsat"
 	"github.com/booleworks/logicng-service/config"
 	"github.com/booleworks/logicng-service/sio"
 )
 
+const auxVarPrefix = "@RESERVED_"
+
 // @Summary      Solve a given set of hard and soft formulas with a MAX-SAT solver
+// @Description  The input can also be given as pseudo-Boolean optimization problem in OPB format (content type 'application/opb').  Its 'min:' objective is transformed to weighted soft literals and the reported optimum is the value of the objective.  The model contains only the variables of the input, the auxiliary variables of constraint encodings are removed.
 // @Tags         Solver
 // @Param        algorithm query string  false "MAX-SAT Algorithm" Enums(oll, msu3, wmsu3, linear-su, linear-us, wbo, inc-wbo)
 // @Param        request body	sio.MaxSatInput true "MAX-SAT input"
@@ -24,10 +27,11 @@ func HandleMaxSat(cfg *config.Config) http.Handler {
 		if !ok {
 			return
 		}
-		if ok := fillMaxSatSolver(w, r, fac, solver); !ok {
+		offset, ok := fillMaxSatSolver(w, r, fac, solver)
+		if !ok {
 			return
 		}
-		hdl := maxsat.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+		hdl := maxSatHandler(r, cfg.SyncComputationTimout)
 		result, ok := solver.SolveWithHandler(hdl)
 		if !ok {
 			sio.WriteError(w, r, sio.ErrTimeout())
@@ -35,12 +39,14 @@ func HandleMaxSat(cfg *config.Config) http.Handler {
 			var mdl []string
 			if result.Satisfiable {
 				solverModel, _ := solver.Model()
-				mdl = make([]string, solverModel.Size())
-				for i, l := range solverModel.Literals {
-					mdl[i] = l.Sprint(fac)
+				mdl = make([]string, 0, solverModel.Size())
+				for _, l := range solverModel.Literals {
+					if name, _, _ := fac.LitNamePhase(l); !strings.HasPrefix(name, auxVarPrefix) {
+						mdl = append(mdl, l.Sprint(fac))
+					}
 				}
 			}
-			sio.WriteMaxSatResult(w, r, result.Satisfiable, int64(result.Optimum), mdl)
+			sio.WriteMaxSatResult(w, r, result.Satisfiable, int64(result.Optimum)+offset, mdl)
 		}
 	})
 }
@@ -70,17 +76,17 @@ func extractMaxSatSolver(w http.ResponseWriter, r *http.Request, fac formula.Fac
 	return solver, true
 }
 
-func fillMaxSatSolver(w http.ResponseWriter, r *http.Request, fac formula.Factory, solver *maxsat.Solver) bool {
+func fillMaxSatSolver(w http.ResponseWriter, r *http.Request, fac formula.Factory, solver *maxsat.Solver) (int64, bool) {
 	input, err := sio.Unmarshal[sio.MaxSatInput](r)
 	if err != nil {
 		sio.WriteError(w, r, err)
-		return false
+		return 0, false
 	}
 	for _, f := range input.HardFormulas {
 		parsed, ok := parse(w, r, fac, f)
 		if !ok {
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("could not parse hard formula '%s'", f)))
-			return false
+			return 0, false
 		}
 		solver.AddHardFormula(parsed)
 	}
@@ -90,20 +96,20 @@ func fillMaxSatSolver(w http.ResponseWriter, r *http.Request, fac formula.Factor
 		parsed, ok := parse(w, r, fac, sio.Formula{Formula: f})
 		if !ok {
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("could not parse soft formula '%s'", f)))
-			return false
+			return 0, false
 		}
 		if weight > 1 {
 			realWeighted = true
 		}
 		if weight > 1 && !suppWeighted {
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("algorithm does not support weighted instances")))
-			return false
+			return 0, false
 		}
 		solver.AddSoftFormula(parsed, int(weight))
 	}
 	if !solver.SupportsUnweighted() && !realWeighted {
 		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("algorithm does not support unweighted instances")))
-		return false
+		return 0, false
 	}
-	return true
+	return input.Offset, true
 }
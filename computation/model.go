@@ -1,9 +1,11 @@
 package computation
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/booleworks/logicng-go/bdd"
@@ -19,19 +21,26 @@ import (
 )
 
 // @Summary      Count the satisfying models of a formula
-// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.
+// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.  The assumption literals are conjoined to the formulas and, if the formulas are unsatisfiable under them, an empty result with a minimal subset of failed assumptions is returned.  The 'approx' algorithm computes a hashing-based approximation which lies within a factor of 1+epsilon of the exact count with probability 1-delta and returns an sio.ApproxCountResult with the confidence bounds.
 // @Tags         Model
-// @Param        algorithm query string  false "Counting Algorithm" Enums(bdd, dnnf, sat) Default(dnnf)
+// @Param        algorithm query string  false "Counting Algorithm" Enums(bdd, dnnf, sat, approx) Default(dnnf)
+// @Param        epsilon query number false "Tolerance of the approximate count" Default(0.8)
+// @Param        delta query number false "Error probability of the approximate count" Default(0.2)
+// @Param        seed query int false "Seed for the approximate count"
 // @Param        request body	sio.FormulaInput true "Formula input"
 // @Success      200  {object}  sio.StringResult
 // @Router       /model/counting [post]
 func HandleModelCounting(cfg *config.Config) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fac := formula.NewFactory()
-		formulas, ok := parseFormulaInput(w, r, fac)
+		formulas, failed, ok := parseAssumptionInput(w, r, fac, cfg.SyncComputationTimout)
 		if !ok {
 			return
 		}
+		if failed != nil {
+			writeUnsatCount(w, r, failed)
+			return
+		}
 		vars := formula.Variables(fac, formulas...).Content()
 		var count *big.Int
 		switch algorithm := r.URL.Query().Get("algorithm"); algorithm {
@@ -41,8 +50,12 @@ func HandleModelCounting(cfg *config.Config) http.Handler {
 			count, ok = countBDD(w, r, fac, formulas, vars, cfg.SyncComputationTimout)
 		case "sat":
 			count, ok = countSat(w, r, fac, formulas, vars, cfg.SyncComputationTimout)
+		case "approx":
+			countApprox(w, r, fac, formulas, vars, cfg.SyncComputationTimout)
+			return
 		default:
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown model counting algorithm '%s'", algorithm)))
+			ok = false
 		}
 		if ok {
 			sio.WriteStringResult(w, r, count.String())
@@ -51,9 +64,12 @@ func HandleModelCounting(cfg *config.Config) http.Handler {
 }
 
 // @Summary      Count the models of a formula projected to a set of variables
-// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.
+// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.  The assumption literals are conjoined to the formulas and, if the formulas are unsatisfiable under them, an empty result with a minimal subset of failed assumptions is returned.  The 'bdd' algorithm existentially quantifies all other variables of a BDD, the 'dnnf' algorithm compiles a d-DNNF which decides only on the projection variables, the 'sat' algorithm enumerates the projected models, and the 'approx' algorithm computes a hashing-based approximation which lies within a factor of 1+epsilon of the exact count with probability 1-delta and returns an sio.ApproxCountResult with the confidence bounds.
 // @Tags         Model
-// @Param        algorithm query string  false "Counting Algorithm" Enums(sat) Default(sat)
+// @Param        algorithm query string  false "Counting Algorithm" Enums(bdd, dnnf, sat, approx) Default(sat)
+// @Param        epsilon query number false "Tolerance of the approximate count" Default(0.8)
+// @Param        delta query number false "Error probability of the approximate count" Default(0.2)
+// @Param        seed query int false "Seed for the approximate count"
 // @Param        request body	sio.FormulaVarsInput true "Formulas and variables input"
 // @Success      200  {object}  sio.StringResult
 // @Router       /model/counting/projection [post]
@@ -65,10 +81,17 @@ func HandleProjectedModelCounting(cfg *config.Config) http.Handler {
 			sio.WriteError(w, r, err)
 			return
 		}
-		formulas, ok := parseFormulas(w, r, fac, input.Formulas)
+		useSolverConfig(fac, input.SolverConfig)
+		formulas, failed, ok := parseFormulasWithAssumptions(
+			w, r, fac, input.Formulas, input.Assumptions, cfg.SyncComputationTimout,
+		)
 		if !ok {
 			return
 		}
+		if failed != nil {
+			writeUnsatCount(w, r, failed)
+			return
+		}
 		vars := make([]formula.Variable, len(input.Variables))
 		for i, v := range input.Variables {
 			vars[i] = fac.Var(v)
@@ -78,10 +101,16 @@ func HandleProjectedModelCounting(cfg *config.Config) http.Handler {
 		switch algorithm := r.URL.Query().Get("algorithm"); algorithm {
 		case "sat", "":
 			count, ok = countSat(w, r, fac, formulas, vars, cfg.SyncComputationTimout)
-		// case "bdd": // TODO not yet working
-		// 	count, ok = countBDD(w, r, fac, formulas, vars, cfg.SyncComputationTimout)
+		case "bdd":
+			count, ok = countBDD(w, r, fac, formulas, vars, cfg.SyncComputationTimout)
+		case "dnnf":
+			count, ok = countProjectedDNNF(w, r, fac, formulas, vars, cfg.SyncComputationTimout)
+		case "approx":
+			countApprox(w, r, fac, formulas, vars, cfg.SyncComputationTimout)
+			return
 		default:
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown projected model counting algorithm '%s'", algorithm)))
+			ok = false
 		}
 		if ok {
 			sio.WriteStringResult(w, r, count.String())
@@ -90,33 +119,55 @@ func HandleProjectedModelCounting(cfg *config.Config) http.Handler {
 }
 
 // @Summary      Enumerate the satisfying models of a formula
-// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.
+// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.  The assumption literals are conjoined to the formulas and, if the formulas are unsatisfiable under them, an empty result with a minimal subset of failed assumptions is returned.  With a 'limit' or a 'cursor' the models are returned page by page as sio.ModelPageResult.  Each page holds at most 'limit' models and, if it is truncated by the limit or the timeout, the cursor for the next page.  The BDD enumeration also returns the total number of models.  With output 'cubes' a sio.CubeResult with disjoint partial models covering all models is returned: the paths of the BDD or the SAT models reduced to prime implicants.
 // @Tags         Model
 // @Param        algorithm query string  false "Enumeration Algorithm" Enums(bdd, sat) Default(bdd)
+// @Param        limit query int  false "Maximum number of models of a page"
+// @Param        cursor query string  false "Cursor of the previous page"
+// @Param        output query string  false "Output of models or of cubes with don't cares" Enums(models, cubes) Default(models)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Formula input"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /model/enumeration [post]
 func HandleModelEnumeration(cfg *config.Config) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fac := formula.NewFactory()
-		fs, ok := parseFormulaInput(w, r, fac)
+		fs, failed, ok := parseAssumptionInput(w, r, fac, cfg.SyncComputationTimout)
 		if !ok {
 			return
 		}
 		vars := formula.Variables(fac, fs...).Content()
+		if failed != nil {
+			writeUnsatEnumeration(w, r, fac, vars, failed)
+			return
+		}
+		switch output := r.URL.Query().Get("output"); output {
+		case "cubes":
+			enumerateCubes(w, r, fac, fs, vars, cfg.SyncComputationTimout)
+			return
+		case "models", "":
+		default:
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown output '%s'", output)))
+			return
+		}
+		if pagedEnumeration(r) {
+			enumeratePage(w, r, fac, fs, vars, nil, cfg.SyncComputationTimout)
+			return
+		}
 		var enumeration []*model.Model
 		switch algorithm := r.URL.Query().Get("algorithm"); algorithm {
 		case "bdd", "":
-			enumeration, ok = enumerateBDD(w, r, fac, fs, vars, cfg.SyncComputationTimout)
+			enumeration, ok = enumerateBDD(w, r, fac, fs, vars, nil, cfg.SyncComputationTimout)
 		case "sat":
-			enumeration, ok = enumerateSat(w, r, fac, fs, vars, cfg.SyncComputationTimout)
+			enumeration, ok = enumerateSat(w, r, fac, fs, vars, nil, cfg.SyncComputationTimout)
 		default:
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown model counting algorithm '%s'", algorithm)))
+			ok = false
 		}
 		if ok {
 			formulas := make([]sio.Formula, len(enumeration))
 			for i, m := range enumeration {
-				formulas[i] = sio.Formula{Formula: m.Formula(fac).Sprint(fac)}
+				formulas[i] = sioFormula(r, fac, m.Formula(fac))
 			}
 			sio.WriteFormulaResult(w, r, formulas...)
 		}
@@ -124,9 +175,13 @@ func HandleModelEnumeration(cfg *config.Config) http.Handler {
 }
 
 // @Summary      Enumerate the satisfying models of a formula projected to a set of variables
-// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.
+// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.  The assumption literals are conjoined to the formulas and, if the formulas are unsatisfiable under them, an empty result with a minimal subset of failed assumptions is returned.  Each model also contains the additional variables with the values of one extension of the model, which are not enumerated over.  With a 'limit' or a 'cursor' the models are returned page by page as sio.ModelPageResult.  Each page holds at most 'limit' models and, if it is truncated by the limit or the timeout, the cursor for the next page.  The BDD enumeration also returns the total number of models.  With output 'cubes' a sio.CubeResult with disjoint partial models covering all models is returned: the paths of the BDD or the SAT models reduced to prime implicants.
 // @Tags         Model
 // @Param        algorithm query string  false "Enumeration Algorithm" Enums(bdd, sat) Default(bdd)
+// @Param        limit query int  false "Maximum number of models of a page"
+// @Param        cursor query string  false "Cursor of the previous page"
+// @Param        output query string  false "Output of models or of cubes with don't cares" Enums(models, cubes) Default(models)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaVarsInput true "Formulas and variables input"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /model/enumeration/projection [post]
@@ -138,7 +193,10 @@ func HandleProjectedModelEnumeration(cfg *config.Config) http.Handler {
 			sio.WriteError(w, r, err)
 			return
 		}
-		fs, ok := parseFormulas(w, r, fac, input.Formulas)
+		useSolverConfig(fac, input.SolverConfig)
+		fs, failed, ok := parseFormulasWithAssumptions(
+			w, r, fac, input.Formulas, input.Assumptions, cfg.SyncComputationTimout,
+		)
 		if !ok {
 			return
 		}
@@ -146,26 +204,80 @@ func HandleProjectedModelEnumeration(cfg *config.Config) http.Handler {
 		for i, v := range input.Variables {
 			vars[i] = fac.Var(v)
 		}
+		if failed != nil {
+			writeUnsatEnumeration(w, r, fac, vars, failed)
+			return
+		}
+		additional := additionalVariables(fac, input.AdditionalVariables, vars)
+		switch output := r.URL.Query().Get("output"); output {
+		case "cubes":
+			if len(additional) > 0 {
+				sio.WriteError(w, r, sio.ErrIllegalInput(errors.New("cube output does not support additional variables")))
+				return
+			}
+			enumerateCubes(w, r, fac, fs, vars, cfg.SyncComputationTimout)
+			return
+		case "models", "":
+		default:
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown output '%s'", output)))
+			return
+		}
+		if pagedEnumeration(r) {
+			enumeratePage(w, r, fac, fs, vars, additional, cfg.SyncComputationTimout)
+			return
+		}
 
 		var enumeration []*model.Model
 		switch algorithm := r.URL.Query().Get("algorithm"); algorithm {
 		case "bdd", "":
-			enumeration, ok = enumerateBDD(w, r, fac, fs, vars, cfg.SyncComputationTimout)
+			enumeration, ok = enumerateBDD(w, r, fac, fs, vars, additional, cfg.SyncComputationTimout)
 		case "sat":
-			enumeration, ok = enumerateSat(w, r, fac, fs, vars, cfg.SyncComputationTimout)
+			enumeration, ok = enumerateSat(w, r, fac, fs, vars, additional, cfg.SyncComputationTimout)
 		default:
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown model counting algorithm '%s'", algorithm)))
+			ok = false
 		}
 		if ok {
 			formulas := make([]sio.Formula, len(enumeration))
 			for i, m := range enumeration {
-				formulas[i] = sio.Formula{Formula: m.Formula(fac).Sprint(fac)}
+				formulas[i] = sioFormula(r, fac, m.Formula(fac))
 			}
 			sio.WriteFormulaResult(w, r, formulas...)
 		}
 	})
 }
 
+// writeUnsatCount writes the zero count of formulas which are unsatisfiable
+// under their assumptions together with the failed assumptions.
+func writeUnsatCount(w http.ResponseWriter, r *http.Request, failed []string) {
+	if r.URL.Query().Get("algorithm") == "approx" {
+		sio.WriteUnsatApproxCountResult(w, r, failed)
+	} else {
+		sio.WriteUnsatStringResult(w, r, "0", failed)
+	}
+}
+
+// writeUnsatEnumeration writes the empty enumeration of formulas which are
+// unsatisfiable under their assumptions in the requested output together
+// with the failed assumptions.
+func writeUnsatEnumeration(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	vars []formula.Variable,
+	failed []string,
+) {
+	switch {
+	case r.URL.Query().Get("output") == "cubes":
+		names, _ := sioCubes(fac, sortedByName(fac, vars), nil)
+		sio.WriteUnsatCubeResult(w, r, names, failed)
+	case pagedEnumeration(r):
+		sio.WriteUnsatModelPageResult(w, r, failed)
+	default:
+		sio.WriteUnsatFormulaResult(w, r, failed)
+	}
+}
+
 func countDNNF(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -195,20 +307,69 @@ func countBDD(
 	vars []formula.Variable,
 	timeout time.Duration,
 ) (*big.Int, bool) {
+	projected, numQuantified, ok := compileProjectedBDD(w, r, fac, formulas, vars, timeout)
+	if !ok {
+		return nil, false
+	}
+	count := projected.ModelCount()
+	return count.Rsh(count, uint(numQuantified)), true
+}
+
+// compileProjectedBDD compiles the conjunction of the formulas to a BDD and
+// existentially quantifies all variables which are not in the projection.
+// The quantified variables remain in the kernel and count as don't cares, so
+// their number is returned as well.  The kernel also contains the auxiliary
+// variables of constraint encodings.
+func compileProjectedBDD(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	timeout time.Duration,
+) (*bdd.BDD, int, bool) {
 	hdl := bdd.HandlerWithTimeout(*handler.NewTimeoutWithDuration(timeout))
 	f := fac.And(formulas...)
+	projection := formula.NewVarSet(vars...)
 	order := bdd.ForceOrder(fac, f)
-	bdd, ok := bdd.CompileWithVarOrderAndHandler(fac, f, order, hdl)
-	allVars := formula.NewMutableVarSetCopy(formula.Variables(fac, formulas...))
-	allVars.RemoveAllElements(&vars)
-	if !allVars.Empty() {
-		bdd = bdd.Exists(allVars.Content()...)
+	for _, v := range projection.Content() {
+		if !slices.Contains(order, v) {
+			order = append(order, v)
+		}
 	}
+	compiled, ok := bdd.CompileWithVarOrderAndHandler(fac, f, order, hdl)
+	if !ok {
+		sio.WriteError(w, r, sio.ErrTimeout())
+		return nil, 0, false
+	}
+	sio.StatsOf(r).SetBDD(compiled)
+	var quantified []formula.Variable
+	for _, v := range compiled.VariableOrder() {
+		if !projection.Contains(v) {
+			quantified = append(quantified, v)
+		}
+	}
+	if len(quantified) > 0 {
+		compiled = compiled.Exists(quantified...)
+	}
+	return compiled, len(quantified), true
+}
+
+func countProjectedDNNF(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	timeout time.Duration,
+) (*big.Int, bool) {
+	compiled, ok := compileProjectedDNNF(fac, formulas, vars, handler.NewTimeoutWithDuration(timeout))
 	if !ok {
 		sio.WriteError(w, r, sio.ErrTimeout())
 		return nil, false
 	}
-	return bdd.ModelCount(), true
+	sio.StatsOf(r).SetDNNF(fac, compiled.Formula)
+	return compiled.ModelCount(), true
 }
 
 func countSat(
@@ -221,7 +382,7 @@ func countSat(
 ) (*big.Int, bool) {
 	f := fac.And(formulas...)
 	cfg := iter.DefaultConfig()
-	cfg.Handler = iter.HandlerWithTimeout(*handler.NewTimeoutWithDuration(timeout))
+	cfg.Handler = iterHandler(r, timeout)
 	cnt, ok := count.OnFormulaWithConfig(fac, f, vars, cfg)
 	if !ok {
 		sio.WriteError(w, r, sio.ErrTimeout())
@@ -236,22 +397,37 @@ func enumerateBDD(
 	fac formula.Factory,
 	formulas []formula.Formula,
 	vars []formula.Variable,
+	additional []formula.Variable,
 	timeout time.Duration,
 ) ([]*model.Model, bool) {
-	hdl := bdd.HandlerWithTimeout(*handler.NewTimeoutWithDuration(timeout))
-	f := fac.And(formulas...)
-	order := bdd.ForceOrder(fac, f)
-	bdd, ok := bdd.CompileWithVarOrderAndHandler(fac, f, order, hdl)
-	allVars := formula.NewMutableVarSetCopy(formula.Variables(fac, formulas...))
-	allVars.RemoveAllElements(&vars)
-	if !allVars.Empty() {
-		bdd = bdd.Exists(allVars.Content()...)
-	}
+	completion, _, ok := compileProjectedBDD(w, r, fac, formulas, append(slices.Clone(vars), additional...), timeout)
 	if !ok {
-		sio.WriteError(w, r, sio.ErrTimeout())
 		return nil, false
 	}
-	return bdd.ModelEnumeration(vars...), true
+	projected := completion
+	if len(additional) > 0 {
+		projected = completion.Exists(additional...)
+	}
+	models := projected.ModelEnumeration(vars...)
+	for _, m := range models {
+		completeModel(completion, m, additional)
+	}
+	return models, true
+}
+
+// completeModel adds the literals of the additional variables of one model
+// of the completion BDD which extends the given model.
+func completeModel(completion *bdd.BDD, m *model.Model, additional []formula.Variable) {
+	if len(additional) == 0 {
+		return
+	}
+	extension, _ := completion.Restrict(m.Literals...).ModelWithVariables(false, additional...)
+	vars := formula.NewVarSet(additional...)
+	for _, lit := range extension.Literals {
+		if vars.Contains(lit.Variable()) {
+			m.AddLiteral(lit)
+		}
+	}
 }
 
 func enumerateSat(
@@ -260,12 +436,13 @@ func enumerateSat(
 	fac formula.Factory,
 	formulas []formula.Formula,
 	vars []formula.Variable,
+	additional []formula.Variable,
 	timeout time.Duration,
 ) ([]*model.Model, bool) {
 	f := fac.And(formulas...)
 	cfg := iter.DefaultConfig()
-	cfg.Handler = iter.HandlerWithTimeout(*handler.NewTimeoutWithDuration(timeout))
-	enumeration, ok := enum.OnFormulaWithConfig(fac, f, vars, cfg)
+	cfg.Handler = iterHandler(r, timeout)
+	enumeration, ok := enum.OnFormulaWithConfig(fac, f, vars, cfg, additional...)
 	if !ok {
 		sio.WriteError(w, r, sio.ErrTimeout())
 		return nil, false
@@ -0,0 +1,105 @@
+package computation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// nnfWriter numbers the nodes of an NNF such that children precede their
+// parents and shared sub-formulas occur only once.
+type nnfWriter struct {
+	fac      formula.Factory
+	vars     map[formula.Variable]int
+	varNames []string
+	nodes    []formula.Formula
+	children [][]int
+	ids      map[formula.Formula]int
+	numEdges int
+}
+
+func newNNFWriter(fac formula.Factory, f formula.Formula) *nnfWriter {
+	w := &nnfWriter{fac: fac, vars: make(map[formula.Variable]int), ids: make(map[formula.Formula]int)}
+	for _, v := range formula.Variables(fac, f).Content() {
+		name, _ := fac.VarName(v)
+		w.varNames = append(w.varNames, name)
+		w.vars[v] = len(w.varNames)
+	}
+	w.add(f)
+	return w
+}
+
+func (w *nnfWriter) add(f formula.Formula) int {
+	if id, ok := w.ids[f]; ok {
+		return id
+	}
+	var children []int
+	if f.Sort() == formula.SortAnd || f.Sort() == formula.SortOr {
+		ops, _ := w.fac.NaryOperands(f)
+		children = make([]int, len(ops))
+		for i, op := range ops {
+			children[i] = w.add(op)
+		}
+		w.numEdges += len(ops)
+	}
+	id := len(w.nodes)
+	w.nodes = append(w.nodes, f)
+	w.children = append(w.children, children)
+	w.ids[f] = id
+	return id
+}
+
+// c2d writes the NNF in the format of the c2d and d4 compilers.  The variable
+// names are written as comment lines 'c <index> <name>' before the header.
+func (w *nnfWriter) c2d() string {
+	var sb strings.Builder
+	for i, name := range w.varNames {
+		sb.WriteString(fmt.Sprintf("c %d %s\n", i+1, name))
+	}
+	sb.WriteString(fmt.Sprintf("nnf %d %d %d\n", len(w.nodes), w.numEdges, len(w.varNames)))
+	for i, f := range w.nodes {
+		switch f.Sort() {
+		case formula.SortTrue:
+			sb.WriteString("A 0")
+		case formula.SortFalse:
+			sb.WriteString("O 0 0")
+		case formula.SortLiteral:
+			name, phase, _ := w.fac.LiteralNamePhase(f)
+			index := w.vars[w.fac.Var(name)]
+			if !phase {
+				index = -index
+			}
+			sb.WriteString(fmt.Sprintf("L %d", index))
+		case formula.SortAnd:
+			sb.WriteString(fmt.Sprintf("A %d", len(w.children[i])))
+		default:
+			sb.WriteString(fmt.Sprintf("O 0 %d", len(w.children[i])))
+		}
+		for _, child := range w.children[i] {
+			sb.WriteString(fmt.Sprintf(" %d", child))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func (w *nnfWriter) graph() ([]sio.Node, []sio.Edge) {
+	nodes := make([]sio.Node, len(w.nodes))
+	edges := make([]sio.Edge, 0, w.numEdges)
+	for i, f := range w.nodes {
+		label := "and"
+		switch f.Sort() {
+		case formula.SortOr:
+			label = "or"
+		case formula.SortTrue, formula.SortFalse, formula.SortLiteral:
+			label = f.Sprint(w.fac)
+		}
+		nodes[i] = sio.Node{ID: int32(i), Label: label}
+		for _, child := range w.children[i] {
+			edges = append(edges, sio.Edge{SrcID: int32(i), DestID: int32(child)})
+		}
+	}
+	return nodes, edges
+}
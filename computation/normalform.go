@@ -1,13 +1,13 @@
 package computation
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/booleworks/logicng-go/bdd"
 	"github.com/booleworks/logicng-go/formula"
 	"github.com/booleworks/logicng-go/handler"
 	"github.com/booleworks/logicng-go/model/enum"
-	"github.com/booleworks/logicng-go/model/iter"
 	"github.com/booleworks/logicng-go/normalform"
 	"github.com/booleworks/logicng-service/config"
 	"github.com/booleworks/logicng-service/sio"
@@ -34,6 +34,7 @@ func HandleNFTrans(cfg *config.Config) http.Handler {
 // @Summary      Transform a formula to negation normal form
 // @Description  If a list of formulas is given, the normal form is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Normal Form
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /normalform/transformation/nnf [post]
@@ -44,9 +45,11 @@ func handleNFTransNNF(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary      Transform a formula to conjunctive normal form
-// @Description  If a list of formulas is given, the normal form is computed for the conjunction of these formulas.  The result always contains exactly one formula.
+// @Description  If a list of formulas is given, the normal form is computed for the conjunction of these formulas.  The result always contains exactly one formula.  With output 'clauses' or 'int-clauses' the clauses are returned as lists of literals in a sio.ClauseResult.
 // @Tags         Normal Form
 // @Param        algorithm query string  false "CNF Algorithm" Enums(advanced, tseitin, pg, factorization, canonical, bdd)
+// @Param        output query string  false "Output representation" Enums(formulas, clauses, int-clauses) Default(formulas)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /normalform/transformation/cnf [post]
@@ -74,7 +77,7 @@ func handleNFTransCNF(w http.ResponseWriter, r *http.Request, cfg *config.Config
 		}
 	case "canonical":
 		method = func(fac formula.Factory, f []formula.Formula) (formula.Formula, sio.ServiceError) {
-			hdl := iter.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+			hdl := iterHandler(r, cfg.SyncComputationTimout)
 			result, ok := enum.CanonicalCNFWithHandler(fac, fac.And(f...), hdl)
 			return transformWithTimeout(result, ok)
 		}
@@ -85,13 +88,15 @@ func handleNFTransCNF(w http.ResponseWriter, r *http.Request, cfg *config.Config
 			return transformWithTimeout(result, ok)
 		}
 	}
-	transform(w, r, method)
+	transformToNF(w, r, cnfNF, method)
 }
 
 // @Summary      Transform a formula to disjunctive normal form
-// @Description  If a list of formulas is given, the normal form is computed for the conjunction of these formulas.  The result always contains exactly one formula.
+// @Description  If a list of formulas is given, the normal form is computed for the conjunction of these formulas.  The result always contains exactly one formula.  With output 'clauses' or 'int-clauses' the terms are returned as lists of literals in a sio.ClauseResult.
 // @Tags         Normal Form
 // @Param        algorithm query string false "DNF Algorithm" Enums(factorization, canonical, bdd)
+// @Param        output query string  false "Output representation" Enums(formulas, clauses, int-clauses) Default(formulas)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /normalform/transformation/dnf [post]
@@ -107,7 +112,7 @@ func handleNFTransDNF(w http.ResponseWriter, r *http.Request, cfg *config.Config
 		}
 	case "canonical":
 		method = func(fac formula.Factory, f []formula.Formula) (formula.Formula, sio.ServiceError) {
-			hdl := iter.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+			hdl := iterHandler(r, cfg.SyncComputationTimout)
 			result, ok := enum.CanonicalDNFWithHandler(fac, fac.And(f...), hdl)
 			return transformWithTimeout(result, ok)
 		}
@@ -118,19 +123,44 @@ func handleNFTransDNF(w http.ResponseWriter, r *http.Request, cfg *config.Config
 			return transformWithTimeout(result, ok)
 		}
 	}
-	transform(w, r, method)
+	transformToNF(w, r, dnfNF, method)
 }
 
 // @Summary      Transform a formula to an and-inverter-graph
-// @Description  If a list of formulas is given, the normal form is computed for the conjunction of these formulas.  The result always contains exactly one formula.
+// @Description  If a list of formulas is given, the normal form is computed for the conjunction of these formulas.  The result always contains exactly one formula.  With the output 'aag' (ASCII) or 'aig' (binary) the graph is written in the AIGER format instead.  Then each formula is an output of the graph named by its description and the inputs are named by the variables.  AIGER files can also be sent as input with the content type 'application/aiger'.
 // @Tags         Normal Form
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        output query string  false "Output representation" Enums(formulas, aag, aig) Default(formulas)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /normalform/transformation/aig [post]
 func handleNFTransAIG(w http.ResponseWriter, r *http.Request) {
-	transform(w, r, func(fac formula.Factory, form []formula.Formula) (formula.Formula, sio.ServiceError) {
-		return normalform.AIG(fac, fac.And(form...)), nil
-	})
+	switch output := r.URL.Query().Get("output"); output {
+	case "", "formulas":
+		transform(w, r, func(fac formula.Factory, form []formula.Formula) (formula.Formula, sio.ServiceError) {
+			return normalform.AIG(fac, fac.And(form...)), nil
+		})
+	case "aag", "aig":
+		fac := formula.NewFactory()
+		ps, ok := parsePropInput(w, r, fac)
+		if !ok {
+			return
+		}
+		fs := make([]formula.Formula, len(ps))
+		descriptions := make([]string, len(ps))
+		for i, p := range ps {
+			fs[i] = p.Formula()
+			descriptions[i] = p.Description
+		}
+		aiger := newAIGERWriter(fac, fs)
+		if output == "aag" {
+			sio.WriteTextResult(w, r, string(aiger.write(false, descriptions)))
+		} else {
+			sio.WriteBinaryResult(w, r, "application/aiger", aiger.write(true, descriptions))
+		}
+	default:
+		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown output '%s'", output)))
+	}
 }
 
 // @Summary      Report whether a formula is an a certain normal form
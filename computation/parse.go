@@ -3,9 +3,11 @@ package computation
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/booleworks/logicng-go/formula"
 	"github.com/booleworks/logicng-go/parser"
+	"github.com/booleworks/logicng-go/sat"
 	"github.com/booleworks/logicng-service/sio"
 )
 
@@ -15,15 +17,98 @@ func parseFormulaInput(w http.ResponseWriter, r *http.Request, fac formula.Facto
 		sio.WriteError(w, r, err)
 		return nil, false
 	}
+	useSolverConfig(fac, input.SolverConfig)
 	return parseFormulas(w, r, fac, input.Formulas)
 }
 
+// parseAssumptionInput parses formulas and their assumptions.  The assumption
+// literals are conjoined to the formulas.  If the formulas are unsatisfiable
+// under the assumptions, the failed assumptions are returned as well.
+func parseAssumptionInput(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	timeout time.Duration,
+) ([]formula.Formula, []string, bool) {
+	input, err := sio.Unmarshal[sio.FormulaInput](r)
+	if err != nil {
+		sio.WriteError(w, r, err)
+		return nil, nil, false
+	}
+	useSolverConfig(fac, input.SolverConfig)
+	return parseFormulasWithAssumptions(w, r, fac, input.Formulas, input.Assumptions, timeout)
+}
+
+// useSolverConfig registers the solver config on the factory, so it is used by
+// all SAT solvers created for the factory, including the ones of LogicNG's
+// algorithms.
+func useSolverConfig(fac formula.Factory, cfg *sio.SolverConfig) {
+	if cfg != nil {
+		_ = fac.PutConfiguration(cfg.SatConfig())
+	}
+}
+
+func parseFormulasWithAssumptions(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	strings []sio.Formula,
+	assumptions []string,
+	timeout time.Duration,
+) ([]formula.Formula, []string, bool) {
+	formulas, ok := parseFormulas(w, r, fac, strings)
+	if !ok {
+		return nil, nil, false
+	}
+	lits, ok := parseAssumptions(w, r, fac, assumptions)
+	if !ok {
+		return nil, nil, false
+	}
+	var failed []string
+	if len(lits) > 0 {
+		hdl := satHandler(r, timeout)
+		solver := sat.NewSolver(fac)
+		solver.Add(formulas...)
+		result := solver.Call(sat.WithAssumptions(lits).Handler(hdl))
+		if result.Aborted() {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return nil, nil, false
+		}
+		if !result.Sat() {
+			if failed, ok = failedAssumptions(solver, lits, hdl); !ok {
+				sio.WriteError(w, r, sio.ErrTimeout())
+				return nil, nil, false
+			}
+		}
+	}
+	for _, lit := range lits {
+		formulas = append(formulas, lit.AsFormula())
+	}
+	return formulas, failed, true
+}
+
+func parseAssumptions(w http.ResponseWriter, r *http.Request, fac formula.Factory, assumptions []string) ([]formula.Literal, bool) {
+	lits := make([]formula.Literal, len(assumptions))
+	for i, a := range assumptions {
+		parsed, err := parser.New(fac).Parse(a)
+		if err == nil {
+			lits[i], err = parsed.AsLiteral()
+		}
+		if err != nil {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("assumption '%s' is not a literal", a)))
+			return nil, false
+		}
+	}
+	return lits, true
+}
+
 func parsePropInput(w http.ResponseWriter, r *http.Request, fac formula.Factory) ([]*formula.StandardProposition, bool) {
 	input, err := sio.Unmarshal[sio.FormulaInput](r)
 	if err != nil {
 		sio.WriteError(w, r, err)
 		return nil, false
 	}
+	useSolverConfig(fac, input.SolverConfig)
 	return parseProps(w, r, fac, input.Formulas)
 }
 
@@ -46,12 +131,14 @@ func parse(
 	fac formula.Factory,
 	formula sio.Formula,
 ) (formula.Formula, bool) {
-	p := parser.New(fac)
-	form, err := p.Parse(formula.Formula)
+	stats := sio.StatsOf(r)
+	defer stats.AddParseTime(time.Now())
+	form, err := parseFormula(fac, formula)
 	if err != nil {
 		sio.WriteError(w, r, sio.ErrIllegalInput(err))
 		return 0, false
 	}
+	stats.AddFormula(fac, form)
 	return form, true
 }
 
@@ -79,11 +166,23 @@ func parseProp(
 	fac formula.Factory,
 	input sio.Formula,
 ) (*formula.StandardProposition, bool) {
-	p := parser.New(fac)
-	form, err := p.Parse(input.Formula)
+	stats := sio.StatsOf(r)
+	defer stats.AddParseTime(time.Now())
+	form, err := parseFormula(fac, input)
 	if err != nil {
 		sio.WriteError(w, r, sio.ErrIllegalInput(err))
 		return nil, false
 	}
+	stats.AddFormula(fac, form)
 	return formula.NewStandardProposition(form, input.Description), true
 }
+
+func parseFormula(fac formula.Factory, input sio.Formula) (formula.Formula, error) {
+	if f, ok := input.Build(fac); ok {
+		return f, nil
+	}
+	if input.AST != nil {
+		return astToFormula(fac, input.AST)
+	}
+	return parser.New(fac).Parse(input.Formula)
+}
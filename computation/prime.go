@@ -5,9 +5,7 @@ import (
 	"net/http"
 
 	"github.com/booleworks/logicng-go/formula"
-	"github.com/booleworks/logicng-go/handler"
 	"github.com/booleworks/logicng-go/primeimplicant"
-	"github.com/booleworks/logicng-go/sat"
 	"github.com/booleworks/logicng-service/config"
 	"github.com/booleworks/logicng-service/sio"
 )
@@ -15,6 +13,7 @@ import (
 // @Summary      Compute a minimal prime implicant of a formula
 // @Description  If a list of formulas is given, the prime implicant is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Prime Implicant
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /prime/minimal-implicant [post]
@@ -30,7 +29,7 @@ func HandleMinimalImplicant(cfg *config.Config) http.Handler {
 			sio.WriteError(w, r, sio.ErrIllegalInput(err))
 		} else {
 			implicantFormula := fac.And(formula.LiteralsAsFormulas(implicant)...)
-			sio.WriteFormulaResult(w, r, sio.Formula{Formula: implicantFormula.Sprint(fac)})
+			sio.WriteFormulaResult(w, r, sioFormula(r, fac, implicantFormula))
 		}
 	})
 }
@@ -39,6 +38,7 @@ func HandleMinimalImplicant(cfg *config.Config) http.Handler {
 // @Description  If a list of formulas is given, the prime implicant cover is computed for the conjunction of these formulas.
 // @Tags         Prime Implicant
 // @Param        algorithm query string  false "min or max models" Enums(min, max) Default(max)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /prime/minimal-cover [post]
@@ -51,7 +51,7 @@ func HandleMinimalImplicantCover(cfg *config.Config) http.Handler {
 		}
 		form := fac.And(formulas...)
 
-		hdl := sat.OptimizationHandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+		hdl := optimizationHandler(r, cfg.SyncComputationTimout)
 		var result *primeimplicant.PrimeResult
 		switch algorithm := r.URL.Query().Get("algorithm"); algorithm {
 		case "max", "":
@@ -68,8 +68,7 @@ func HandleMinimalImplicantCover(cfg *config.Config) http.Handler {
 		}
 		implicants := make([]sio.Formula, len(result.Implicants))
 		for i, impl := range result.Implicants {
-			f := fac.And(formula.LiteralsAsFormulas(impl)...).Sprint(fac)
-			implicants[i] = sio.Formula{Formula: f}
+			implicants[i] = sioFormula(r, fac, fac.And(formula.LiteralsAsFormulas(impl)...))
 		}
 		sio.WriteFormulaResult(w, r, implicants...)
 	})
@@ -0,0 +1,151 @@
+package computation
+
+import (
+	"github.com/booleworks/logicng-go/assignment"
+	"github.com/booleworks/logicng-go/dnnf"
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/graph"
+	"github.com/booleworks/logicng-go/handler"
+	"github.com/booleworks/logicng-go/normalform"
+	"github.com/booleworks/logicng-go/sat"
+)
+
+// projectionCompiler compiles a CNF to a d-DNNF over the projection variables
+// which is equivalent to the CNF with all other variables existentially
+// quantified.  Only projection variables are used for decisions, components
+// without projection variables are replaced by their satisfiability, which is
+// checked with a SAT handler on the same timeout.
+type projectionCompiler struct {
+	fac        formula.Factory
+	proj       *formula.VarSet
+	cache      map[formula.Formula]formula.Formula
+	timeout    *handler.Timeout
+	satHandler sat.Handler
+}
+
+// compileProjectedDNNF compiles the conjunction of the formulas to a d-DNNF
+// projected to the given variables.  The auxiliary variables of the CNF
+// transformation are quantified away like all other non-projection variables.
+func compileProjectedDNNF(
+	fac formula.Factory,
+	formulas []formula.Formula,
+	vars []formula.Variable,
+	timeout *handler.Timeout,
+) (*dnnf.DNNF, bool) {
+	c := &projectionCompiler{
+		fac:        fac,
+		proj:       formula.NewVarSet(vars...),
+		cache:      make(map[formula.Formula]formula.Formula),
+		timeout:    timeout,
+		satHandler: sat.HandlerWithTimeout(*timeout),
+	}
+	cnf := normalform.CNF(fac, fac.And(formulas...), normalform.DefaultCNFConfig())
+	compiled, ok := c.compile(cnf)
+	if !ok {
+		return nil, false
+	}
+	return &dnnf.DNNF{Fac: fac, Formula: compiled, OriginalVars: c.proj}, true
+}
+
+func (c *projectionCompiler) compile(cnf formula.Formula) (formula.Formula, bool) {
+	if cnf.Sort() == formula.SortTrue || cnf.Sort() == formula.SortFalse {
+		return cnf, true
+	}
+	if cached, ok := c.cache[cnf]; ok {
+		return cached, true
+	}
+	if c.timeout.TimeLimitExceeded() {
+		return 0, false
+	}
+	clauses := []formula.Formula{cnf}
+	if cnf.Sort() == formula.SortAnd {
+		clauses, _ = c.fac.NaryOperands(cnf)
+	}
+
+	var result formula.Formula
+	var ok bool
+	if unit, found := unitClause(clauses); found {
+		result, ok = c.propagate(cnf, formula.Literal(unit))
+	} else if components := c.components(clauses); len(components) > 1 {
+		compiled := make([]formula.Formula, len(components))
+		for i, component := range components {
+			if compiled[i], ok = c.compile(c.fac.And(component...)); !ok {
+				return 0, false
+			}
+		}
+		result, ok = c.fac.And(compiled...), true
+	} else if decision, found := c.decisionVariable(cnf); found {
+		result, ok = c.decide(cnf, decision)
+	} else {
+		solver := sat.NewSolver(c.fac)
+		solver.Add(cnf)
+		sResult := solver.Call(sat.Params().Handler(c.satHandler))
+		if sResult.Aborted() {
+			return 0, false
+		}
+		result, ok = c.fac.Constant(sResult.Sat()), true
+	}
+	if ok {
+		c.cache[cnf] = result
+	}
+	return result, ok
+}
+
+func unitClause(clauses []formula.Formula) (formula.Formula, bool) {
+	for _, clause := range clauses {
+		if clause.Sort() == formula.SortLiteral {
+			return clause, true
+		}
+	}
+	return 0, false
+}
+
+// propagate assigns the literal of a unit clause.  The literal is only kept in
+// the result if its variable is a projection variable.
+func (c *projectionCompiler) propagate(cnf formula.Formula, lit formula.Literal) (formula.Formula, bool) {
+	ass, _ := assignment.New(c.fac, lit)
+	rest, ok := c.compile(assignment.Restrict(c.fac, cnf, ass))
+	if !ok {
+		return 0, false
+	}
+	if c.proj.Contains(lit.Variable()) {
+		return c.fac.And(lit.AsFormula(), rest), true
+	}
+	return rest, true
+}
+
+func (c *projectionCompiler) components(clauses []formula.Formula) [][]formula.Formula {
+	constraintGraph := graph.GenerateConstraintGraph(c.fac, clauses...)
+	ccs := graph.ComputeConnectedComponents(constraintGraph)
+	return graph.SplitFormulasByComponent(c.fac, clauses, ccs)
+}
+
+// decisionVariable returns the projection variable with the most occurrences
+// in the CNF.
+func (c *projectionCompiler) decisionVariable(cnf formula.Formula) (formula.Variable, bool) {
+	var decision formula.Variable
+	occurrences := 0
+	for v, count := range formula.VariableProfile(c.fac, cnf) {
+		if c.proj.Contains(v) && (count > occurrences || count == occurrences && v < decision) {
+			decision, occurrences = v, count
+		}
+	}
+	return decision, occurrences > 0
+}
+
+func (c *projectionCompiler) decide(cnf formula.Formula, v formula.Variable) (formula.Formula, bool) {
+	branches := make([]formula.Formula, 2)
+	for i, phase := range []bool{true, false} {
+		lit := v.AsLiteral()
+		if !phase {
+			lit = lit.Negate(c.fac)
+		}
+		ass, _ := assignment.New(c.fac, lit)
+		branch, ok := c.compile(assignment.Restrict(c.fac, cnf, ass))
+		if !ok {
+			return 0, false
+		}
+		branches[i] = c.fac.And(lit.AsFormula(), branch)
+	}
+	return c.fac.Or(branches...), true
+}
@@ -19,6 +19,7 @@ import (
 // @Param        vars query int false "Number of variables"
 // @Param        seed query int false "Seed for the randomizer"
 // @Param        formulas query int false "Number of formulas to generate"
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /randomizer/{fsort} [get]
 func HandleRandomizer(cfg *config.Config) http.Handler {
@@ -76,7 +77,7 @@ func HandleRandomizer(cfg *config.Config) http.Handler {
 		}
 		res := make([]sio.Formula, numForms)
 		for i := 0; i < numForms; i++ {
-			res[i] = sio.Formula{Formula: randGen().Sprint(fac)}
+			res[i] = sioFormula(r, fac, randGen())
 		}
 		sio.WriteFormulaResult(w, r, res...)
 	})
@@ -113,3 +114,17 @@ func extractIntParam(w http.ResponseWriter, r *http.Request, param string, def i
 	}
 	return value, true
 }
+
+func extractFloatParam(w http.ResponseWriter, r *http.Request, param string, def float64) (float64, bool) {
+	value := def
+	valueParam := r.URL.Query().Get(param)
+	if valueParam != "" {
+		var err error
+		value, err = strconv.ParseFloat(valueParam, 64)
+		if err != nil {
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("illegal %s value '%s'", param, valueParam)))
+			return 0, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,112 @@
+package computation
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/sat"
+	"github.com/booleworks/logicng-service/config"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// @Summary      Compute the implication relation among a set of formulas
+// @Description  Each input formula is a node, its ID is the index of the formula in the input.  Equivalent formulas form a class which is represented by its first formula, the other formulas of the class are connected to it with an edge labelled '<=>'.  Between the representatives the Hasse diagram of the implications is returned: an edge labelled '=>' from a formula to a weaker formula, omitting all implications which follow by transitivity.  All pairs are checked with one incremental SAT solver.
+// @Tags         Solver
+// @Param        request body	sio.FormulaInput true "Input formulas"
+// @Success      200  {object}  sio.GraphResult
+// @Router       /solver/relations [post]
+func HandleSatRelations(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fac := formula.NewFactory()
+		fs, ok := parseFormulaInput(w, r, fac)
+		if !ok {
+			return
+		}
+		implies, ok := computeImplications(r, cfg, fac, fs)
+		if !ok {
+			sio.WriteError(w, r, sio.ErrTimeout())
+			return
+		}
+
+		nodes := make([]sio.Node, len(fs))
+		edges := make([]sio.Edge, 0, len(fs))
+		class := make([]int, len(fs))
+		for i, f := range fs {
+			nodes[i] = sio.Node{ID: int32(i), Label: f.Sprint(fac)}
+			class[i] = i
+			for k := 0; k < i; k++ {
+				if class[k] == k && implies[i][k] && implies[k][i] {
+					class[i] = k
+					edges = append(edges, sio.Edge{SrcID: int32(k), DestID: int32(i), Label: "<=>"})
+					break
+				}
+			}
+		}
+		for a := range fs {
+			for b := range fs {
+				if a == b || class[a] != a || class[b] != b || !implies[a][b] {
+					continue
+				}
+				direct := true
+				for c := range fs {
+					if c != a && c != b && class[c] == c && implies[a][c] && implies[c][b] {
+						direct = false
+						break
+					}
+				}
+				if direct {
+					edges = append(edges, sio.Edge{SrcID: int32(a), DestID: int32(b), Label: "=>"})
+				}
+			}
+		}
+		sio.WriteGraphResult(w, r, nodes, edges)
+	})
+}
+
+// computeImplications returns a matrix which holds at [i][j] whether the
+// i-th formula implies the j-th formula.  An implication is checked by
+// solving under the selector of the first and the negated selector of the
+// second formula.
+func computeImplications(
+	r *http.Request,
+	cfg *config.Config,
+	fac formula.Factory,
+	fs []formula.Formula,
+) ([][]bool, bool) {
+	solver, selectors := newSelectorSolver(r, fac, fs)
+
+	hdl := satHandler(r, cfg.SyncComputationTimout)
+	implies := make([][]bool, len(fs))
+	for i := range fs {
+		implies[i] = make([]bool, len(fs))
+		implies[i][i] = true
+		for j := range fs {
+			if i == j {
+				continue
+			}
+			assumptions := []formula.Literal{selectors[i].AsLiteral(), selectors[j].Negate(fac)}
+			result := solver.Call(sat.WithAssumptions(assumptions).Handler(hdl))
+			if result.Aborted() {
+				return nil, false
+			}
+			implies[i][j] = !result.Sat()
+		}
+	}
+	return implies, true
+}
+
+// newSelectorSolver returns a SAT solver on which each formula is equivalent
+// to its selector variable.  Assuming a selector enforces the formula,
+// assuming its negation enforces the negated formula, and an unassumed
+// selector leaves the formula free.
+func newSelectorSolver(r *http.Request, fac formula.Factory, fs []formula.Formula) (*sat.Solver, []formula.Variable) {
+	solver := sat.NewSolver(fac)
+	selectors := make([]formula.Variable, len(fs))
+	for i, f := range fs {
+		selectors[i] = fac.Var(fmt.Sprintf("%sSEL_%d", auxVarPrefix, i))
+		solver.Add(fac.Equivalence(selectors[i].AsFormula(), f))
+	}
+	sio.StatsOf(r).SetSolver(solver)
+	return solver, selectors
+}
@@ -0,0 +1,170 @@
+package computation
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/model"
+	"github.com/booleworks/logicng-service/config"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// maxSamples bounds the number of samples of a request, the samples are held
+// in memory until the result is written.
+const maxSamples = 10000
+
+// @Summary      Sample models of a formula uniformly at random
+// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.  Each model is drawn independently and uniformly from all models projected to the projection variables or, without projection, from all models over the variables of the formulas.  The samples are drawn from a BDD, so the same seed yields the same samples.  An unsatisfiable formula yields no samples.
+// @Tags         Model
+// @Param        n query int false "Number of samples, at most 10000" Default(1)
+// @Param        seed query int false "Seed for the sampler"
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        request body	sio.SamplingInput true "Formulas and optional projection"
+// @Success      200  {object}  sio.FormulaResult
+// @Router       /model/sampling [post]
+func HandleModelSampling(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fac := formula.NewFactory()
+		input, err := sio.Unmarshal[sio.SamplingInput](r)
+		if err != nil {
+			sio.WriteError(w, r, err)
+			return
+		}
+		useSolverConfig(fac, input.SolverConfig)
+		formulas, ok := parseFormulas(w, r, fac, input.Formulas)
+		if !ok {
+			return
+		}
+		projection := formula.Variables(fac, formulas...)
+		if len(input.Projection) > 0 {
+			projection = formula.NewVarSet(varsFromNames(fac, input.Projection)...)
+		}
+		sampleModels(w, r, fac, formulas, newLiteralWeights(fac, nil, projection), cfg)
+	})
+}
+
+// @Summary      Sample models of a formula proportionally to their weight
+// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.  The weight of a model is the product of the weights of its literals, literals without a weight have weight 1.  Each model over the variables of the formulas is drawn independently with a probability proportional to its weight.  The same seed yields the same samples.  Formulas without a model of positive weight yield no samples.
+// @Tags         Model
+// @Param        n query int false "Number of samples, at most 10000" Default(1)
+// @Param        seed query int false "Seed for the sampler"
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
+// @Param        request body	sio.WeightedCountInput true "Formulas and literal weights"
+// @Success      200  {object}  sio.FormulaResult
+// @Router       /model/sampling/weighted [post]
+func HandleWeightedModelSampling(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fac := formula.NewFactory()
+		input, err := sio.Unmarshal[sio.WeightedCountInput](r)
+		if err != nil {
+			sio.WriteError(w, r, err)
+			return
+		}
+		useSolverConfig(fac, input.SolverConfig)
+		formulas, ok := parseFormulas(w, r, fac, input.Formulas)
+		if !ok {
+			return
+		}
+		weights := newLiteralWeights(fac, input.Weights, formula.Variables(fac, formulas...))
+		for lit, weight := range input.Weights {
+			if value, _ := new(big.Rat).SetString(weight); value.Sign() < 0 {
+				sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("negative weight '%s' for literal %s", weight, lit)))
+				return
+			}
+		}
+		sampleModels(w, r, fac, formulas, weights, cfg)
+	})
+}
+
+func sampleModels(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	weights *literalWeights,
+	cfg *config.Config,
+) {
+	n, ok := extractIntParam(w, r, "n", 1)
+	if !ok {
+		return
+	}
+	if n < 0 || n > maxSamples {
+		sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("illegal n value '%d', the maximum is %d", n, maxSamples)))
+		return
+	}
+	seed, ok := extractIntParam(w, r, "seed", int(time.Now().UnixMilli()))
+	if !ok {
+		return
+	}
+	projected, _, ok := compileProjectedBDD(w, r, fac, formulas, weights.vars.Content(), cfg.SyncComputationTimout)
+	if !ok {
+		return
+	}
+	sampler := newWeightedBDD(fac, serializeBDD(fac, projected), weights)
+	if sampler.count().Sign() == 0 {
+		sio.WriteFormulaResult(w, r)
+		return
+	}
+	rnd := rand.New(rand.NewSource(int64(seed)))
+	samples := make([]sio.Formula, n)
+	for i := range samples {
+		samples[i] = sioFormula(r, fac, sampler.sample(rnd).Formula(fac))
+	}
+	sio.WriteFormulaResult(w, r, samples...)
+}
+
+// sample draws a model from the BDD with a probability proportional to its
+// weight.  Starting at the root, each node's branch is chosen according to
+// the weighted model counts of both branches.  Variables skipped on the way
+// are chosen according to the weights of their literals.
+func (b *weightedBDD) sample(rnd *rand.Rand) *model.Model {
+	var literals []formula.Literal
+	choose := func(v formula.Variable, phase bool) {
+		if !b.weights.vars.Contains(v) {
+			return
+		}
+		lit := v.AsLiteral()
+		if !phase {
+			lit = v.Negate(b.weights.fac)
+		}
+		literals = append(literals, lit)
+	}
+	skip := func(from, to int) {
+		for _, v := range b.order[from:to] {
+			if b.weights.vars.Contains(v) {
+				choose(v, draw(rnd, b.weights.weight(v, true), b.weights.smoothing(v)))
+			}
+		}
+	}
+	id := b.bdd.Root
+	level := 0
+	for id > 1 {
+		skip(level, b.nodeLevels[id])
+		node := b.nodes[id]
+		low, high := b.branches(node)
+		phase := draw(rnd, high, low.Add(low, high))
+		choose(b.order[b.nodeLevels[id]], phase)
+		level = b.nodeLevels[id] + 1
+		if phase {
+			id = node.High
+		} else {
+			id = node.Low
+		}
+	}
+	skip(level, len(b.order))
+	return model.New(literals...)
+}
+
+// draw returns true with probability weight/total.  The comparison is exact
+// for rational weights.
+func draw(rnd *rand.Rand, weight, total *big.Rat) bool {
+	if weight.Sign() == 0 {
+		return false
+	}
+	p := new(big.Rat).Quo(weight, total)
+	return new(big.Int).Rand(rnd, p.Denom()).Cmp(p.Num()) < 0
+}
@@ -3,18 +3,20 @@ package computation
 import (
 	"fmt"
 	"net/http"
+	"slices"
 
+	"github.com/booleworks/logicng-go/assignment"
 	"github.com/booleworks/logicng-go/formula"
-	"github.com/booleworks/logicng-go/handler"
 	"github.com/booleworks/logicng-go/sat"
 	"github.com/booleworks/logicng-service/config"
 	"github.com/booleworks/logicng-service/sio"
 )
 
 // @Summary      Compute the satisfiability of a set of formulas with a SAT solver
-// @Description  If a list of formulas is given, the satisfiability is computed for the conjunction of these formulas.
+// @Description  If a list of formulas is given, the satisfiability is computed for the conjunction of these formulas.  The formulas can also be given as pseudo-Boolean constraints in OPB format (content type 'application/opb').  The assumptions are passed to the solver and, if the formulas are unsatisfiable under them, a minimal subset of failed assumptions is reported.
 // @Tags         Solver
 // @Param        core query string  false "Compute an unsat core if unsatisfiable" Enums(false, true) Default(false)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.SatResult
 // @Router       /solver/sat [post]
@@ -22,19 +24,23 @@ func HandleSat(cfg *config.Config) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fac := formula.NewFactory()
 		core := r.URL.Query().Get("core") == "true"
-		satCfg := sat.DefaultConfig().Proofs(core)
-		solver := sat.NewSolver(fac, satCfg)
-		vars, ok := fillSatSolver(w, r, solver)
+		solver, vars, assumptions, ok := fillSatSolver(w, r, fac, core)
 		if !ok {
 			return
 		}
 
-		hdl := sat.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
-		var call *sat.CallParams
+		// the assumptions are passed as own propositions, so that they can be
+		// removed from the unsat core
+		hdl := satHandler(r, cfg.SyncComputationTimout)
+		assumptionProps := make(map[formula.Proposition]bool, len(assumptions))
+		call := sat.WithModel(vars).Handler(hdl)
+		for _, lit := range assumptions {
+			prop := formula.NewStandardProposition(lit.AsFormula())
+			assumptionProps[prop] = true
+			call.Proposition(prop)
+		}
 		if core {
-			call = sat.WithCore().WithModel(vars).Handler(hdl)
-		} else {
-			call = sat.WithModel(vars).Handler(hdl)
+			call = call.WithCore()
 		}
 		result := solver.Call(call)
 		if result.Aborted() {
@@ -49,21 +55,29 @@ func HandleSat(cfg *config.Config) http.Handler {
 				}
 			}
 			var unsatCore []sio.Formula
-			if core && !result.Sat() {
-				props := result.UnsatCore().Propositions
-				unsatCore = make([]sio.Formula, len(props))
-				for i, p := range props {
-					prop := p.(*formula.StandardProposition)
-					unsatCore[i] = sio.Formula{Formula: p.Formula().Sprint(fac), Description: prop.Description}
+			var failed []string
+			if !result.Sat() {
+				if core {
+					unsatCore = []sio.Formula{}
+					for _, p := range result.UnsatCore().Propositions {
+						if !assumptionProps[p] {
+							prop := p.(*formula.StandardProposition)
+							unsatCore = append(unsatCore, sioFormula(r, fac, p.Formula(), prop.Description))
+						}
+					}
+				}
+				if failed, ok = failedAssumptions(solver, assumptions, hdl); !ok {
+					sio.WriteError(w, r, sio.ErrTimeout())
+					return
 				}
 			}
-			sio.WriteSatResult(w, r, result.Sat(), mdl, unsatCore)
+			sio.WriteSatResult(w, r, result.Sat(), mdl, unsatCore, failed)
 		}
 	})
 }
 
 // @Summary      Compute the backbone of a set of formulas
-// @Description  If a list of formulas is given, the backbone is computed for the conjunction of these formulas.
+// @Description  If a list of formulas is given, the backbone is computed for the conjunction of these formulas.  The backbone is computed under the assumptions and, if the formulas are unsatisfiable under them, a minimal subset of failed assumptions is reported.
 // @Tags         Solver
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.BackboneResult
@@ -72,13 +86,29 @@ func HandleSatBackbone(cfg *config.Config) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fac := formula.NewFactory()
 		core := r.URL.Query().Get("core") == "true"
-		satCfg := sat.DefaultConfig().Proofs(core)
-		solver := sat.NewSolver(fac, satCfg)
-		vars, ok := fillSatSolver(w, r, solver)
+		solver, vars, assumptions, ok := fillSatSolver(w, r, fac, core)
 		if !ok {
 			return
 		}
-		hdl := sat.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+		hdl := satHandler(r, cfg.SyncComputationTimout)
+		if len(assumptions) > 0 {
+			result := solver.Call(sat.WithAssumptions(assumptions).Handler(hdl))
+			if result.Aborted() {
+				sio.WriteError(w, r, sio.ErrTimeout())
+				return
+			}
+			if !result.Sat() {
+				if failed, ok := failedAssumptions(solver, assumptions, hdl); ok {
+					sio.WriteUnsatBackboneResult(w, r, failed)
+				} else {
+					sio.WriteError(w, r, sio.ErrTimeout())
+				}
+				return
+			}
+			for _, lit := range assumptions {
+				solver.Add(lit.AsFormula())
+			}
+		}
 		bb, ok := solver.ComputeBackboneWithHandler(fac, vars, hdl)
 		if !ok {
 			sio.WriteError(w, r, sio.ErrTimeout())
@@ -106,62 +136,104 @@ func HandleSatPredicate(cfg *config.Config) http.Handler {
 }
 
 // @Summary      Report whether a formula is a tautology
-// @Description  If a list of formulas is given it is reported of the conjunction of these formulas are a tautology.
+// @Description  If a list of formulas is given it is reported of the conjunction of these formulas are a tautology.  If not, an assignment under which the formulas are false is returned as counterexample.
 // @Tags         Solver
 // @Param        request body	sio.FormulaInput true "Input formulas"
-// @Success      200  {object}  sio.BoolResult
+// @Success      200  {object}  sio.PredicateResult
 // @Router       /solver/predicate/tautology [post]
 func HandleTautology(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
 	handleTautCont(w, r, cfg, true)
 }
 
 // @Summary      Report whether a formula is a contradiction
-// @Description  If a list of formulas is given it is reported of the conjunction of these formulas are a contradiction.
+// @Description  If a list of formulas is given it is reported of the conjunction of these formulas are a contradiction.  If not, an assignment under which the formulas are true is returned as counterexample.
 // @Tags         Solver
 // @Param        request body	sio.FormulaInput true "Input formulas"
-// @Success      200  {object}  sio.BoolResult
+// @Success      200  {object}  sio.PredicateResult
 // @Router       /solver/predicate/contradiction [post]
 func HandleContradiction(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
 	handleTautCont(w, r, cfg, false)
 }
 
 // @Summary      Report whether the first formula implies the second formula
-// @Description  Must be called with exactly two formulas.
+// @Description  Must be called with exactly two formulas.  If the implication does not hold, an assignment under which the first formula is true and the second is false is returned as counterexample.
 // @Tags         Solver
 // @Param        request body	sio.FormulaInput true "Input formulas"
-// @Success      200  {object}  sio.BoolResult
+// @Success      200  {object}  sio.PredicateResult
 // @Router       /solver/predicate/implication [post]
 func HandleImplication(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
 	handleImplEquiv(w, r, cfg, true)
 }
 
 // @Summary      Report whether the first formula and the second formula are equivalent
-// @Description  Must be called with exactly two formulas.
+// @Description  Must be called with exactly two formulas.  If they are not equivalent, an assignment under which exactly one of them is true is returned as counterexample, together with the side ('first' or 'second') which is true.
 // @Tags         Solver
 // @Param        request body	sio.FormulaInput true "Input formulas"
-// @Success      200  {object}  sio.BoolResult
+// @Success      200  {object}  sio.PredicateResult
 // @Router       /solver/predicate/equivalence [post]
 func HandleEquivalence(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
 	handleImplEquiv(w, r, cfg, false)
 }
 
-func fillSatSolver(w http.ResponseWriter, r *http.Request, solver *sat.Solver) ([]formula.Variable, bool) {
+// fillSatSolver creates a SAT solver with the solver config of the input and
+// adds the input formulas to it.
+func fillSatSolver(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	proofs bool,
+) (*sat.Solver, []formula.Variable, []formula.Literal, bool) {
 	input, err := sio.Unmarshal[sio.FormulaInput](r)
 	if err != nil {
 		sio.WriteError(w, r, err)
-		return nil, false
+		return nil, nil, nil, false
 	}
+	useSolverConfig(fac, input.SolverConfig)
+	solver := sat.NewSolver(fac, input.SolverConfig.SatConfig().Proofs(proofs))
 	varSet := formula.NewMutableVarSet()
 	for _, f := range input.Formulas {
-		prop, ok := parseProp(w, r, solver.Factory(), f)
+		prop, ok := parseProp(w, r, fac, f)
 		if !ok {
 			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("could not parse formula '%s'", f)))
-			return nil, false
+			return nil, nil, nil, false
 		}
-		varSet.AddAll(formula.Variables(solver.Factory(), prop.Formula()))
+		varSet.AddAll(formula.Variables(fac, prop.Formula()))
 		solver.AddProposition(prop)
 	}
-	return varSet.Content(), true
+	assumptions, ok := parseAssumptions(w, r, fac, input.Assumptions)
+	if !ok {
+		return nil, nil, nil, false
+	}
+	for _, lit := range assumptions {
+		varSet.Add(lit.Variable())
+	}
+	sio.StatsOf(r).SetSolver(solver)
+	return solver, varSet.Content(), assumptions, true
+}
+
+// failedAssumptions shrinks the assumptions of an unsatisfiable solver to a
+// subset which is still unsatisfiable, but becomes satisfiable as soon as any
+// of its literals is dropped.  If the formulas on the solver are
+// unsatisfiable on their own, the subset is empty.
+func failedAssumptions(solver *sat.Solver, assumptions []formula.Literal, hdl sat.Handler) ([]string, bool) {
+	failed := slices.Clone(assumptions)
+	for i := 0; i < len(failed); {
+		candidate := slices.Delete(slices.Clone(failed), i, i+1)
+		result := solver.Call(sat.WithAssumptions(candidate).Handler(hdl))
+		if result.Aborted() {
+			return nil, false
+		}
+		if result.Sat() {
+			i++
+		} else {
+			failed = candidate
+		}
+	}
+	names := make([]string, len(failed))
+	for i, lit := range failed {
+		names[i] = lit.Sprint(solver.Factory())
+	}
+	return names, true
 }
 
 func handleTautCont(w http.ResponseWriter, r *http.Request, cfg *config.Config, taut bool) {
@@ -176,12 +248,13 @@ func handleTautCont(w http.ResponseWriter, r *http.Request, cfg *config.Config,
 	} else {
 		solver.Add(fac.And(fs...))
 	}
-	hdl := sat.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
-	result := solver.Call(sat.Params().Handler(hdl))
+	hdl := satHandler(r, cfg.SyncComputationTimout)
+	vars := formula.Variables(fac, fs...).Content()
+	result := solver.Call(sat.WithModel(vars).Handler(hdl))
 	if result.Aborted() {
 		sio.WriteError(w, r, sio.ErrTimeout())
 	} else {
-		sio.WriteBoolResult(w, r, !result.Sat())
+		sio.WritePredicateResult(w, r, !result.Sat(), counterexample(fac, result), "")
 	}
 }
 
@@ -201,11 +274,33 @@ func handleImplEquiv(w http.ResponseWriter, r *http.Request, cfg *config.Config,
 	} else {
 		solver.Add(fac.Not(fac.Equivalence(fs[0], fs[1])))
 	}
-	hdl := sat.HandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
-	result := solver.Call(sat.Params().Handler(hdl))
+	hdl := satHandler(r, cfg.SyncComputationTimout)
+	vars := formula.Variables(fac, fs...).Content()
+	result := solver.Call(sat.WithModel(vars).Handler(hdl))
 	if result.Aborted() {
 		sio.WriteError(w, r, sio.ErrTimeout())
-	} else {
-		sio.WriteBoolResult(w, r, !result.Sat())
+		return
+	}
+	var trueSide string
+	if !impl && result.Sat() {
+		ass, _ := result.Model().Assignment(fac)
+		if assignment.Evaluate(fac, fs[0], ass) {
+			trueSide = "first"
+		} else {
+			trueSide = "second"
+		}
+	}
+	sio.WritePredicateResult(w, r, !result.Sat(), counterexample(fac, result), trueSide)
+}
+
+// counterexample returns the model of a satisfiable call as list of literals.
+func counterexample(fac formula.Factory, result sat.CallResult) []string {
+	if !result.Sat() {
+		return nil
+	}
+	lits := make([]string, result.Model().Size())
+	for i, l := range result.Model().Literals {
+		lits[i] = l.Sprint(fac)
 	}
+	return lits
 }
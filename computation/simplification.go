@@ -5,9 +5,7 @@ import (
 	"net/http"
 
 	"github.com/booleworks/logicng-go/formula"
-	"github.com/booleworks/logicng-go/handler"
 	"github.com/booleworks/logicng-go/normalform"
-	"github.com/booleworks/logicng-go/sat"
 	"github.com/booleworks/logicng-go/simplification"
 	"github.com/booleworks/logicng-service/config"
 	"github.com/booleworks/logicng-service/sio"
@@ -42,6 +40,7 @@ func HandleSimplification(cfg *config.Config) http.Handler {
 // @Summary      Simplify a formula by computing and propagating its backbone
 // @Description  If a list of formulas is given, the simplification is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Simplification
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /simplification/backbone [post]
@@ -54,6 +53,7 @@ func handleSimplBackbone(w http.ResponseWriter, r *http.Request) {
 // @Summary      Simplify a formula by propagating its unit literals
 // @Description  If a list of formulas is given, the simplification is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Simplification
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /simplification/unitpropagation [post]
@@ -66,6 +66,7 @@ func handleSimplUnitProp(w http.ResponseWriter, r *http.Request) {
 // @Summary      Simplify a formula by minimizing the number of negations
 // @Description  If a list of formulas is given, the simplification is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Simplification
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /simplification/negation [post]
@@ -78,6 +79,7 @@ func handleSimplNegation(w http.ResponseWriter, r *http.Request) {
 // @Summary      Simplify a formula by applying the distributive laws
 // @Description  If a list of formulas is given, the simplification is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Simplification
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /simplification/distribution [post]
@@ -90,6 +92,7 @@ func handleSimplDistribution(w http.ResponseWriter, r *http.Request) {
 // @Summary      Simplify a formula by factoring out common factors repetitively
 // @Description  If a list of formulas is given, the simplification is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Simplification
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /simplification/factorout [post]
@@ -102,6 +105,7 @@ func handleSimplFactorOut(w http.ResponseWriter, r *http.Request) {
 // @Summary      Simplify a CNF or DNF by applying subsumptions
 // @Description  If a list of formulas is given, the simplification is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Simplification
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /simplification/subsumption [post]
@@ -124,12 +128,13 @@ func handleSimplSubsumption(w http.ResponseWriter, r *http.Request) {
 // @Summary      Simplify a formula with the Quine-McCluskey algorithm
 // @Description  If a list of formulas is given, the simplification is computed for the conjunction of these formulas.  The result always contains exactly one formula.
 // @Tags         Simplification
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /simplification/qmc [post]
 func handleSimplQMC(w http.ResponseWriter, r *http.Request, cfg *config.Config) {
 	transform(w, r, func(fac formula.Factory, fs []formula.Formula) (formula.Formula, sio.ServiceError) {
-		hdl := sat.OptimizationHandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+		hdl := optimizationHandler(r, cfg.SyncComputationTimout)
 		result, ok := simplification.QMCWithHandler(fac, fac.And(fs...), hdl)
 		return transformWithTimeout(result, ok)
 	})
@@ -141,6 +146,7 @@ func handleSimplQMC(w http.ResponseWriter, r *http.Request, cfg *config.Config)
 // @Param        backbone query string false "Simplify with backbone" Enums(true, false) default(true)
 // @Param        factorout query string false "Factor out common factors" Enums(true, false) default(true)
 // @Param        negations query string false "Minimize negations" Enums(true, false) default(true)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /simplification/advanced [post]
@@ -156,7 +162,7 @@ func handleSimplAdvanced(w http.ResponseWriter, r *http.Request, cfg *config.Con
 		if r.URL.Query().Get("negations") == "false" {
 			simpCfg.SimplifyNegations = false
 		}
-		hdl := sat.OptimizationHandlerWithTimeout(*handler.NewTimeoutWithDuration(cfg.SyncComputationTimout))
+		hdl := optimizationHandler(r, cfg.SyncComputationTimout)
 		result, ok := simplification.AdvancedWithHandler(fac, fac.And(fs...), hdl, simpCfg)
 		return transformWithTimeout(result, ok)
 	})
@@ -0,0 +1,96 @@
+package computation
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/booleworks/logicng-go/handler"
+	"github.com/booleworks/logicng-go/maxsat"
+	"github.com/booleworks/logicng-go/model/iter"
+	"github.com/booleworks/logicng-go/sat"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// The handlers of this file wrap the timeout handlers of LogicNG and record
+// the events of the solvers in the statistics of the request.  Without
+// requested statistics the plain timeout handlers are used.
+
+type satStatsHandler struct {
+	sat.Handler
+	stats *sio.Stats
+}
+
+func (h *satStatsHandler) DetectedConflict() bool {
+	h.stats.AddConflict()
+	return h.Handler.DetectedConflict()
+}
+
+func satHandler(r *http.Request, timeout time.Duration) sat.Handler {
+	hdl := sat.HandlerWithTimeout(*handler.NewTimeoutWithDuration(timeout))
+	if stats := sio.StatsOf(r); stats != nil {
+		return &satStatsHandler{hdl, stats}
+	}
+	return hdl
+}
+
+type iterStatsHandler struct {
+	iter.Handler
+	sat sat.Handler
+}
+
+func (h *iterStatsHandler) SatHandler() sat.Handler {
+	return h.sat
+}
+
+func iterHandler(r *http.Request, timeout time.Duration) iter.Handler {
+	hdl := iter.HandlerWithTimeout(*handler.NewTimeoutWithDuration(timeout))
+	if stats := sio.StatsOf(r); stats != nil {
+		return &iterStatsHandler{hdl, &satStatsHandler{hdl.SatHandler(), stats}}
+	}
+	return hdl
+}
+
+type optimizationStatsHandler struct {
+	sat.OptimizationHandler
+	sat sat.Handler
+}
+
+func (h *optimizationStatsHandler) SatHandler() sat.Handler {
+	return h.sat
+}
+
+func optimizationHandler(r *http.Request, timeout time.Duration) sat.OptimizationHandler {
+	hdl := sat.OptimizationHandlerWithTimeout(*handler.NewTimeoutWithDuration(timeout))
+	if stats := sio.StatsOf(r); stats != nil {
+		return &optimizationStatsHandler{hdl, &satStatsHandler{hdl.SatHandler(), stats}}
+	}
+	return hdl
+}
+
+// maxSatIterationHandler counts each SAT call of a MaxSAT algorithm as one
+// iteration.
+type maxSatIterationHandler struct {
+	satStatsHandler
+}
+
+func (h *maxSatIterationHandler) Started() {
+	h.stats.AddMaxSatIteration()
+	h.Handler.Started()
+}
+
+type maxSatStatsHandler struct {
+	maxsat.Handler
+	sat sat.Handler
+}
+
+func (h *maxSatStatsHandler) SatHandler() sat.Handler {
+	return h.sat
+}
+
+func maxSatHandler(r *http.Request, timeout time.Duration) maxsat.Handler {
+	hdl := maxsat.HandlerWithTimeout(*handler.NewTimeoutWithDuration(timeout))
+	if stats := sio.StatsOf(r); stats != nil {
+		return &maxSatStatsHandler{hdl, &maxSatIterationHandler{satStatsHandler{hdl.SatHandler(), stats}}}
+	}
+	return hdl
+}
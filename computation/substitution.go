@@ -28,6 +28,7 @@ func HandleSubstitution(cfg *config.Config) http.Handler {
 // @Description  If a list of formulas is given, the result is computed for each formula independently.
 // @Tags         Substitution
 // @Param        prefix query string false "Optional prefix for the new variables" default(v)
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.FormulaInput true "Input formulas"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /substitution/anonymization [post]
@@ -52,12 +53,13 @@ func handleSubstAnonymization(w http.ResponseWriter, r *http.Request) {
 	trans := func(fac formula.Factory, p *formula.StandardProposition) (formula.Formula, sio.ServiceError) {
 		return anon.Anonymize(p.Formula()), nil
 	}
-	transformPropostions(w, r, fac, trans, ps)
+	transformPropostions(w, r, fac, noNF, trans, ps)
 }
 
 // @Summary      Replace variables in a formula by their given substitution formula
 // @Description  If a list of formulas is given, the result is computed for each formula independently.
 // @Tags         Substitution
+// @Param        format query string  false "Formula output format" Enums(string, ast) Default(string)
 // @Param        request body	sio.SubstitutionInput true "Input formulas and Substitution"
 // @Success      200  {object}  sio.FormulaResult
 // @Router       /substitution/variables [post]
@@ -85,7 +87,7 @@ func handleSubstVariable(w http.ResponseWriter, r *http.Request) {
 		}
 		return res, nil
 	}
-	transformPropostions(w, r, fac, trans, ps)
+	transformPropostions(w, r, fac, noNF, trans, ps)
 }
 
 func extractSubst(
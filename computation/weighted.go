@@ -0,0 +1,235 @@
+package computation
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/handler"
+	"github.com/booleworks/logicng-service/config"
+	"github.com/booleworks/logicng-service/sio"
+)
+
+// @Summary      Compute the weighted model count of a formula
+// @Description  If a list of formulas is given, the result refers to the conjunction of these formulas.  The weight of a model is the product of the weights of its literals, literals without a weight have weight 1.  The models range over the variables of the formulas.  The exact count is returned as rational number together with a floating point approximation.
+// @Tags         Model
+// @Param        algorithm query string  false "Counting Algorithm" Enums(bdd, dnnf) Default(dnnf)
+// @Param        request body	sio.WeightedCountInput true "Formulas and literal weights"
+// @Success      200  {object}  sio.WeightedCountResult
+// @Router       /model/counting/weighted [post]
+func HandleWeightedModelCounting(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fac := formula.NewFactory()
+		input, err := sio.Unmarshal[sio.WeightedCountInput](r)
+		if err != nil {
+			sio.WriteError(w, r, err)
+			return
+		}
+		useSolverConfig(fac, input.SolverConfig)
+		formulas, ok := parseFormulas(w, r, fac, input.Formulas)
+		if !ok {
+			return
+		}
+		weights := newLiteralWeights(fac, input.Weights, formula.Variables(fac, formulas...))
+
+		var count *big.Rat
+		switch algorithm := r.URL.Query().Get("algorithm"); algorithm {
+		case "dnnf", "":
+			count, ok = weightedCountDNNF(w, r, fac, formulas, weights, cfg)
+		case "bdd":
+			count, ok = weightedCountBDD(w, r, fac, formulas, weights, cfg)
+		default:
+			sio.WriteError(w, r, sio.ErrIllegalInput(fmt.Errorf("unknown weighted model counting algorithm '%s'", algorithm)))
+			ok = false
+		}
+		if ok {
+			sio.WriteWeightedCountResult(w, r, count)
+		}
+	})
+}
+
+// literalWeights maps the variables of the counted formulas to the weights of
+// their positive and negative literal.
+type literalWeights struct {
+	fac      formula.Factory
+	vars     *formula.VarSet
+	positive map[formula.Variable]*big.Rat
+	negative map[formula.Variable]*big.Rat
+}
+
+func newLiteralWeights(fac formula.Factory, weights map[string]string, vars *formula.VarSet) *literalWeights {
+	result := &literalWeights{
+		fac:      fac,
+		vars:     vars,
+		positive: make(map[formula.Variable]*big.Rat),
+		negative: make(map[formula.Variable]*big.Rat),
+	}
+	for lit, weight := range weights {
+		value, _ := new(big.Rat).SetString(weight)
+		if name, negative := strings.CutPrefix(lit, "~"); negative {
+			result.negative[fac.Var(strings.TrimSpace(name))] = value
+		} else {
+			result.positive[fac.Var(strings.TrimSpace(lit))] = value
+		}
+	}
+	return result
+}
+
+func (l *literalWeights) weight(v formula.Variable, phase bool) *big.Rat {
+	weights := l.negative
+	if phase {
+		weights = l.positive
+	}
+	if weight, ok := weights[v]; ok {
+		return weight
+	}
+	return big.NewRat(1, 1)
+}
+
+// smoothing returns the factor of a variable which does not occur on a path,
+// i.e. the sum of the weights of both literals.  Variables which do not occur
+// in the counted formulas have a factor of 1.
+func (l *literalWeights) smoothing(v formula.Variable) *big.Rat {
+	if !l.vars.Contains(v) {
+		return big.NewRat(1, 1)
+	}
+	return new(big.Rat).Add(l.weight(v, true), l.weight(v, false))
+}
+
+func weightedCountDNNF(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	weights *literalWeights,
+	cfg *config.Config,
+) (*big.Rat, bool) {
+	timeout := handler.NewTimeoutWithDuration(cfg.SyncComputationTimout)
+	compiled, ok := compileProjectedDNNF(fac, formulas, weights.vars.Content(), timeout)
+	if !ok {
+		sio.WriteError(w, r, sio.ErrTimeout())
+		return nil, false
+	}
+	sio.StatsOf(r).SetDNNF(fac, compiled.Formula)
+	cache := make(map[formula.Formula]*big.Rat)
+	var count func(f formula.Formula) *big.Rat
+	count = func(f formula.Formula) *big.Rat {
+		if cached, ok := cache[f]; ok {
+			return cached
+		}
+		result := new(big.Rat)
+		switch f.Sort() {
+		case formula.SortTrue:
+			result.SetInt64(1)
+		case formula.SortLiteral:
+			lit := formula.Literal(f)
+			result.Set(weights.weight(lit.Variable(), lit.IsPos()))
+		case formula.SortAnd:
+			result.SetInt64(1)
+			for _, op := range fac.Operands(f) {
+				result.Mul(result, count(op))
+			}
+		case formula.SortOr:
+			vars := formula.Variables(fac, f)
+			for _, op := range fac.Operands(f) {
+				summand := new(big.Rat).Set(count(op))
+				result.Add(result, summand.Mul(summand, smoothing(weights, vars, formula.Variables(fac, op))))
+			}
+		}
+		cache[f] = result
+		return result
+	}
+	result := new(big.Rat).Set(count(compiled.Formula))
+	return result.Mul(result, smoothing(weights, weights.vars, formula.Variables(fac, compiled.Formula))), true
+}
+
+// smoothing returns the product of the smoothing factors of all variables in
+// vars which are not in the covered variables.
+func smoothing(weights *literalWeights, vars, covered *formula.VarSet) *big.Rat {
+	result := big.NewRat(1, 1)
+	for _, v := range vars.Content() {
+		if !covered.Contains(v) {
+			result.Mul(result, weights.smoothing(v))
+		}
+	}
+	return result
+}
+
+func weightedCountBDD(
+	w http.ResponseWriter,
+	r *http.Request,
+	fac formula.Factory,
+	formulas []formula.Formula,
+	weights *literalWeights,
+	cfg *config.Config,
+) (*big.Rat, bool) {
+	projected, _, ok := compileProjectedBDD(w, r, fac, formulas, weights.vars.Content(), cfg.SyncComputationTimout)
+	if !ok {
+		return nil, false
+	}
+	return newWeightedBDD(fac, serializeBDD(fac, projected), weights).count(), true
+}
+
+// weightedBDD holds the weighted model counts of all nodes of a serialised
+// BDD.  Variables which are skipped on an edge contribute their smoothing
+// factor.
+type weightedBDD struct {
+	bdd        *sio.BDD
+	weights    *literalWeights
+	order      []formula.Variable
+	nodes      map[int32]sio.BDDNode
+	nodeLevels map[int32]int
+	counts     map[int32]*big.Rat
+}
+
+func newWeightedBDD(fac formula.Factory, serialized *sio.BDD, weights *literalWeights) *weightedBDD {
+	b := &weightedBDD{
+		bdd:        serialized,
+		weights:    weights,
+		order:      varsFromNames(fac, serialized.Order),
+		nodes:      make(map[int32]sio.BDDNode, len(serialized.Nodes)),
+		nodeLevels: map[int32]int{0: len(serialized.Order), 1: len(serialized.Order)},
+		counts:     map[int32]*big.Rat{0: new(big.Rat), 1: big.NewRat(1, 1)},
+	}
+	levels := make(map[string]int, len(serialized.Order))
+	for i, name := range serialized.Order {
+		levels[name] = i
+	}
+	for _, node := range serialized.Nodes {
+		level := levels[node.Variable]
+		b.nodes[node.ID] = node
+		b.nodeLevels[node.ID] = level
+		low, high := b.branches(node)
+		b.counts[node.ID] = low.Add(low, high)
+	}
+	return b
+}
+
+// skipped returns the product of the smoothing factors of the levels from
+// (inclusive) to to (exclusive).
+func (b *weightedBDD) skipped(from, to int) *big.Rat {
+	result := big.NewRat(1, 1)
+	for _, v := range b.order[from:to] {
+		result.Mul(result, b.weights.smoothing(v))
+	}
+	return result
+}
+
+// branches returns the weighted model counts of the low and the high branch
+// of a node including the weight of the node's literal.
+func (b *weightedBDD) branches(node sio.BDDNode) (*big.Rat, *big.Rat) {
+	level := b.nodeLevels[node.ID]
+	v := b.order[level]
+	low := new(big.Rat).Mul(b.counts[node.Low], b.skipped(level+1, b.nodeLevels[node.Low]))
+	low.Mul(low, b.weights.weight(v, false))
+	high := new(big.Rat).Mul(b.counts[node.High], b.skipped(level+1, b.nodeLevels[node.High]))
+	high.Mul(high, b.weights.weight(v, true))
+	return low, high
+}
+
+func (b *weightedBDD) count() *big.Rat {
+	result := new(big.Rat).Set(b.counts[b.bdd.Root])
+	return result.Mul(result, b.skipped(0, b.nodeLevels[b.bdd.Root]))
+}
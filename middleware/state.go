@@ -9,7 +9,11 @@ import (
 
 func AddState(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := context.WithValue(r.Context(), sio.State{}, &sio.ComputationState{Success: true})
+		state := &sio.ComputationState{Success: true}
+		if r.URL.Query().Get("stats") == "true" {
+			state.Stats = sio.NewStats()
+		}
+		ctx := context.WithValue(r.Context(), sio.State{}, state)
 		handler.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -0,0 +1,267 @@
+package sio
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/booleworks/logicng-go/formula"
+)
+
+type aigerInput[T any] interface {
+	DeserAIGER([]byte) (T, error)
+}
+
+type aigerGraph struct {
+	inputs  []uint64
+	outputs []uint64
+	gates   map[uint64][2]uint64
+	iNames  map[int]string
+	oNames  map[int]string
+}
+
+// DeserAIGER reads a combinational and-inverter graph in the ASCII (aag) or
+// binary (aig) AIGER format.  Each output yields one formula described by its
+// symbol name, inputs are named by their symbols or 'i<index>' otherwise.
+// Since gates are shared, the formulas are built directly on the factory with
+// one formula per gate.
+func (FormulaInput) DeserAIGER(data []byte) (FormulaInput, error) {
+	g, err := parseAIGER(data)
+	if err != nil {
+		return FormulaInput{}, err
+	}
+	visited := make(map[uint64]bool)
+	for _, in := range g.inputs {
+		visited[in>>1] = true
+	}
+	for _, out := range g.outputs {
+		if err := g.check(out, visited, make(map[uint64]bool)); err != nil {
+			return FormulaInput{}, err
+		}
+	}
+	// all outputs share the formulas of the gates on the same factory
+	var fac formula.Factory
+	var formulas map[uint64]formula.Formula
+	result := make([]Formula, len(g.outputs))
+	for i, out := range g.outputs {
+		build := func(f formula.Factory) formula.Formula {
+			if f != fac {
+				fac, formulas = f, g.inputFormulas(f)
+			}
+			return g.literal(fac, out, formulas)
+		}
+		result[i] = Formula{Description: g.oNames[i], build: build}
+	}
+	return FormulaInput{Formulas: result}, nil
+}
+
+// check reports undefined literals and cycles in the cone of the literal.
+// Visited holds the checked variable indices, path those of the current path.
+func (g *aigerGraph) check(lit uint64, visited, path map[uint64]bool) error {
+	index := lit >> 1
+	if index == 0 || visited[index] {
+		return nil
+	}
+	if path[index] {
+		return fmt.Errorf("AIGER input contains a cycle")
+	}
+	gate, isGate := g.gates[index]
+	if !isGate {
+		return fmt.Errorf("undefined AIGER literal %d", lit)
+	}
+	path[index] = true
+	for _, child := range gate {
+		if err := g.check(child, visited, path); err != nil {
+			return err
+		}
+	}
+	delete(path, index)
+	visited[index] = true
+	return nil
+}
+
+func (g *aigerGraph) inputFormulas(fac formula.Factory) map[uint64]formula.Formula {
+	formulas := make(map[uint64]formula.Formula, len(g.inputs)+len(g.gates))
+	for i, in := range g.inputs {
+		name, ok := g.iNames[i]
+		if !ok {
+			name = fmt.Sprintf("i%d", i)
+		}
+		formulas[in>>1] = fac.Variable(name)
+	}
+	return formulas
+}
+
+func (g *aigerGraph) literal(fac formula.Factory, lit uint64, formulas map[uint64]formula.Formula) formula.Formula {
+	var f formula.Formula
+	switch index := lit >> 1; {
+	case index == 0:
+		f = fac.Falsum()
+	default:
+		cached, ok := formulas[index]
+		if !ok {
+			gate := g.gates[index]
+			cached = fac.And(g.literal(fac, gate[0], formulas), g.literal(fac, gate[1], formulas))
+			formulas[index] = cached
+		}
+		f = cached
+	}
+	if lit&1 == 1 {
+		return fac.Not(f)
+	}
+	return f
+}
+
+func parseAIGER(data []byte) (*aigerGraph, error) {
+	reader := bufio.NewReader(bytes.NewReader(data))
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("missing AIGER header")
+	}
+	fields := strings.Fields(header)
+	if len(fields) < 6 || fields[0] != "aag" && fields[0] != "aig" {
+		return nil, fmt.Errorf("illegal AIGER header '%s'", strings.TrimSpace(header))
+	}
+	numbers := make([]uint64, len(fields)-1)
+	for i, field := range fields[1:] {
+		if numbers[i], err = strconv.ParseUint(field, 10, 64); err != nil {
+			return nil, fmt.Errorf("illegal AIGER header '%s'", strings.TrimSpace(header))
+		}
+	}
+	maxVar, numInputs, numLatches, numOutputs, numAnds := numbers[0], numbers[1], numbers[2], numbers[3], numbers[4]
+	if numLatches > 0 {
+		return nil, fmt.Errorf("AIGER latches are not supported")
+	}
+	for _, n := range numbers[5:] {
+		if n > 0 {
+			return nil, fmt.Errorf("AIGER bad states, constraints, justice, and fairness properties are not supported")
+		}
+	}
+	binary := fields[0] == "aig"
+	g := &aigerGraph{gates: make(map[uint64][2]uint64), iNames: make(map[int]string), oNames: make(map[int]string)}
+
+	if binary {
+		for i := uint64(1); i <= numInputs; i++ {
+			g.inputs = append(g.inputs, 2*i)
+		}
+	} else {
+		for i := uint64(0); i < numInputs; i++ {
+			values, err := readAIGERLine(reader, 1)
+			if err != nil {
+				return nil, err
+			}
+			g.inputs = append(g.inputs, values[0])
+		}
+	}
+	for i := uint64(0); i < numOutputs; i++ {
+		values, err := readAIGERLine(reader, 1)
+		if err != nil {
+			return nil, err
+		}
+		g.outputs = append(g.outputs, values[0])
+	}
+	for i := uint64(0); i < numAnds; i++ {
+		if binary {
+			lhs := 2 * (numInputs + i + 1)
+			delta0, err := readAIGERDelta(reader)
+			if err != nil {
+				return nil, err
+			}
+			delta1, err := readAIGERDelta(reader)
+			if err != nil {
+				return nil, err
+			}
+			if delta0 > lhs || delta1 > lhs-delta0 {
+				return nil, fmt.Errorf("illegal AIGER and gate %d", lhs)
+			}
+			g.gates[lhs>>1] = [2]uint64{lhs - delta0, lhs - delta0 - delta1}
+		} else {
+			values, err := readAIGERLine(reader, 3)
+			if err != nil {
+				return nil, err
+			}
+			lhs := values[0]
+			if lhs&1 == 1 || lhs < 2 || slices.Contains(g.inputs, lhs) {
+				return nil, fmt.Errorf("illegal AIGER and gate %d", lhs)
+			}
+			if _, defined := g.gates[lhs>>1]; defined {
+				return nil, fmt.Errorf("AIGER and gate %d is defined twice", lhs)
+			}
+			g.gates[lhs>>1] = [2]uint64{values[1], values[2]}
+		}
+	}
+	for _, in := range g.inputs {
+		if in>>1 > maxVar {
+			return nil, fmt.Errorf("AIGER literal %d exceeds maximum variable index %d", in, maxVar)
+		}
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+		line = strings.TrimRight(line, "\r\n")
+		if line == "c" {
+			break
+		}
+		if line == "" {
+			if err != nil {
+				break
+			}
+			continue
+		}
+		name, ok := strings.CutPrefix(line, "i")
+		target := g.iNames
+		if !ok {
+			name, ok = strings.CutPrefix(line, "o")
+			target = g.oNames
+		}
+		if !ok {
+			return nil, fmt.Errorf("illegal AIGER symbol '%s'", line)
+		}
+		position, symbol, found := strings.Cut(name, " ")
+		index, convErr := strconv.Atoi(position)
+		if !found || convErr != nil {
+			return nil, fmt.Errorf("illegal AIGER symbol '%s'", line)
+		}
+		target[index] = symbol
+		if err != nil {
+			break
+		}
+	}
+	return g, nil
+}
+
+func readAIGERLine(reader *bufio.Reader, count int) ([]uint64, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return nil, fmt.Errorf("unexpected end of AIGER input")
+	}
+	fields := strings.Fields(line)
+	if len(fields) != count {
+		return nil, fmt.Errorf("illegal AIGER line '%s'", strings.TrimSpace(line))
+	}
+	values := make([]uint64, count)
+	for i, field := range fields {
+		if values[i], err = strconv.ParseUint(field, 10, 64); err != nil {
+			return nil, fmt.Errorf("illegal AIGER line '%s'", strings.TrimSpace(line))
+		}
+	}
+	return values, nil
+}
+
+func readAIGERDelta(reader *bufio.Reader) (uint64, error) {
+	var value uint64
+	for shift := 0; ; shift += 7 {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("unexpected end of AIGER input")
+		}
+		value |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return value, nil
+		}
+	}
+}
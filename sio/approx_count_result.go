@@ -0,0 +1,83 @@
+package sio
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ApproxCountResult holds an approximate model count.  The exact count lies
+// between the lower and the upper bound with the given confidence.  If the
+// count is exact, both bounds are equal to the value.  If the formulas are
+// unsatisfiable under their assumptions, the exact count is zero and the
+// failed assumptions are given.
+type ApproxCountResult struct {
+	State             ComputationState `json:"state"`
+	Value             string           `json:"value" example:"1024"`
+	LowerBound        string           `json:"lowerBound" example:"568"`
+	UpperBound        string           `json:"upperBound" example:"1844"`
+	Confidence        float64          `json:"confidence" example:"0.8"`
+	Exact             bool             `json:"exact"`
+	FailedAssumptions []string         `json:"failedAssumptions,omitempty" example:"~B"`
+}
+
+func (r ApproxCountResult) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.ApproxCountResult{
+		State:             r.State.toPB(),
+		Value:             r.Value,
+		LowerBound:        r.LowerBound,
+		UpperBound:        r.UpperBound,
+		Confidence:        r.Confidence,
+		Exact:             r.Exact,
+		FailedAssumptions: r.FailedAssumptions,
+	})
+}
+
+func (ApproxCountResult) DeserProtoBuf(data []byte) (ApproxCountResult, error) {
+	result := &pb.ApproxCountResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return ApproxCountResult{}, err
+	}
+	return ApproxCountResult{
+		stateFromPB(result.State),
+		result.Value,
+		result.LowerBound,
+		result.UpperBound,
+		result.Confidence,
+		result.Exact,
+		result.FailedAssumptions,
+	}, nil
+}
+
+func WriteApproxCountResult(
+	w http.ResponseWriter,
+	r *http.Request,
+	value, lower, upper *big.Int,
+	confidence float64,
+	exact bool,
+) {
+	result := ApproxCountResult{
+		State:      successState(r),
+		Value:      value.String(),
+		LowerBound: lower.String(),
+		UpperBound: upper.String(),
+		Confidence: confidence,
+		Exact:      exact,
+	}
+	WriteResult(w, r, result)
+}
+
+func WriteUnsatApproxCountResult(w http.ResponseWriter, r *http.Request, failedAssumptions []string) {
+	result := ApproxCountResult{
+		State:             successState(r),
+		Value:             "0",
+		LowerBound:        "0",
+		UpperBound:        "0",
+		Confidence:        1,
+		Exact:             true,
+		FailedAssumptions: failedAssumptions,
+	}
+	WriteResult(w, r, result)
+}
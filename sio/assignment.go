@@ -25,7 +25,7 @@ func (AssignmentInput) DeserProtoBuf(data []byte) (AssignmentInput, error) {
 	}
 	formulas := make([]Formula, len(input.Formulas))
 	for i, f := range input.Formulas {
-		formulas[i] = Formula{f.Formula, f.Description}
+		formulas[i] = formulaFromPB(f)
 	}
 	return AssignmentInput{Formulas: formulas, Assignment: input.Mapping}, nil
 }
@@ -10,20 +10,22 @@ import (
 )
 
 type BackboneResult struct {
-	State       ComputationState `json:"state"`
-	Satisfiable bool             `json:"satisfiable"`
-	Positive    []string         `json:"positive,omitempty" example:"A, B"`
-	Negative    []string         `json:"negative,omitempty" example:"C, D"`
-	Optional    []string         `json:"optional,omitempty" example:"X, Y"`
+	State             ComputationState `json:"state"`
+	Satisfiable       bool             `json:"satisfiable"`
+	Positive          []string         `json:"positive,omitempty" example:"A, B"`
+	Negative          []string         `json:"negative,omitempty" example:"C, D"`
+	Optional          []string         `json:"optional,omitempty" example:"X, Y"`
+	FailedAssumptions []string         `json:"failedAssumptions,omitempty" example:"~B"`
 }
 
 func (r BackboneResult) ProtoBuf() ([]byte, error) {
 	return proto.Marshal(&pb.BackboneResult{
-		State:       r.State.toPB(),
-		Satisfiable: r.Satisfiable,
-		Positive:    r.Positive,
-		Negative:    r.Negative,
-		Optional:    r.Optional,
+		State:             r.State.toPB(),
+		Satisfiable:       r.Satisfiable,
+		Positive:          r.Positive,
+		Negative:          r.Negative,
+		Optional:          r.Optional,
+		FailedAssumptions: r.FailedAssumptions,
 	})
 }
 
@@ -32,12 +34,14 @@ func (BackboneResult) DeserProtoBuf(data []byte) (BackboneResult, error) {
 	if err := proto.Unmarshal(data, res); err != nil {
 		return BackboneResult{}, err
 	}
-	return BackboneResult{stateFromPB(res.State), res.Satisfiable, res.Positive, res.Negative, res.Optional}, nil
+	return BackboneResult{
+		stateFromPB(res.State), res.Satisfiable, res.Positive, res.Negative, res.Optional, res.FailedAssumptions,
+	}, nil
 }
 
 func WriteBackboneResult(w http.ResponseWriter, r *http.Request, fac formula.Factory, bb *sat.Backbone) {
 	result := BackboneResult{
-		State:       ComputationState{Success: true},
+		State:       successState(r),
 		Satisfiable: bb.Sat,
 		Positive:    extractVarList(fac, bb.Positive),
 		Negative:    extractVarList(fac, bb.Negative),
@@ -46,6 +50,16 @@ func WriteBackboneResult(w http.ResponseWriter, r *http.Request, fac formula.Fac
 	WriteResult(w, r, result)
 }
 
+// WriteUnsatBackboneResult writes the result for formulas which are
+// unsatisfiable under the given failed assumptions.
+func WriteUnsatBackboneResult(w http.ResponseWriter, r *http.Request, failedAssumptions []string) {
+	result := BackboneResult{
+		State:             successState(r),
+		FailedAssumptions: failedAssumptions,
+	}
+	WriteResult(w, r, result)
+}
+
 func extractVarList(fac formula.Factory, vars []formula.Variable) []string {
 	var strings []string
 	if len(vars) > 0 {
@@ -0,0 +1,251 @@
+package sio
+
+import (
+	"net/http"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// BDD is the serialised form of a BDD.  The IDs 0 and 1 denote the constants
+// false and true, inner nodes have IDs starting at 2 and are listed such that
+// their children precede them.
+type BDD struct {
+	Order []string  `json:"order" example:"A,B,C"`
+	Nodes []BDDNode `json:"nodes"`
+	Root  int32     `json:"root" example:"4"`
+}
+
+type BDDNode struct {
+	ID       int32  `json:"id" example:"2"`
+	Variable string `json:"variable" example:"A"`
+	Low      int32  `json:"low" example:"0"`
+	High     int32  `json:"high" example:"1"`
+}
+
+type BDDInput struct {
+	Formulas   []Formula       `json:"formulas,omitempty"`
+	BDD        *BDD            `json:"bdd,omitempty"`
+	Operand    *BDDOperand     `json:"operand,omitempty"`
+	Assignment map[string]bool `json:"assignment,omitempty" example:"A:true,B:false"`
+	Variables  []string        `json:"variables,omitempty" example:"A,C,E"`
+}
+
+// BDDOperand is the second operand of binary BDD operations.
+type BDDOperand struct {
+	Formulas []Formula `json:"formulas,omitempty"`
+	BDD      *BDD      `json:"bdd,omitempty"`
+}
+
+// BDDCompilationInput holds the formulas to compile and an optional explicit
+// variable order.  Variables of the formulas which are missing in the order
+// are appended by the selected ordering heuristic.
+type BDDCompilationInput struct {
+	Formulas []Formula `json:"formulas"`
+	Order    []string  `json:"order,omitempty" example:"A,B,C"`
+}
+
+type BDDResult struct {
+	State ComputationState `json:"state"`
+	BDD   *BDD             `json:"bdd,omitempty"`
+}
+
+func (b *BDD) ProtoBuf() *pb.BDD {
+	if b == nil {
+		return nil
+	}
+	nodes := make([]*pb.BDDNode, len(b.Nodes))
+	for i, n := range b.Nodes {
+		nodes[i] = &pb.BDDNode{Id: n.ID, Variable: n.Variable, Low: n.Low, High: n.High}
+	}
+	return &pb.BDD{Order: b.Order, Nodes: nodes, Root: b.Root}
+}
+
+func bddFromPB(b *pb.BDD) *BDD {
+	if b == nil {
+		return nil
+	}
+	nodes := make([]BDDNode, len(b.Nodes))
+	for i, n := range b.Nodes {
+		nodes[i] = BDDNode{n.Id, n.Variable, n.Low, n.High}
+	}
+	return &BDD{b.Order, nodes, b.Root}
+}
+
+func (i BDDInput) ProtoBuf() ([]byte, error) {
+	var operand *pb.BDDOperand
+	if i.Operand != nil {
+		operand = &pb.BDDOperand{Formulas: formulasToPB(i.Operand.Formulas), Bdd: i.Operand.BDD.ProtoBuf()}
+	}
+	return proto.Marshal(&pb.BDDInput{
+		Formulas:   formulasToPB(i.Formulas),
+		Bdd:        i.BDD.ProtoBuf(),
+		Operand:    operand,
+		Assignment: i.Assignment,
+		Variables:  i.Variables,
+	})
+}
+
+func (BDDInput) DeserProtoBuf(data []byte) (BDDInput, error) {
+	input := &pb.BDDInput{}
+	if err := proto.Unmarshal(data, input); err != nil {
+		return BDDInput{}, err
+	}
+	var operand *BDDOperand
+	if input.Operand != nil {
+		operand = &BDDOperand{formulasFromPB(input.Operand.Formulas), bddFromPB(input.Operand.Bdd)}
+	}
+	return BDDInput{
+		Formulas:   formulasFromPB(input.Formulas),
+		BDD:        bddFromPB(input.Bdd),
+		Operand:    operand,
+		Assignment: input.Assignment,
+		Variables:  input.Variables,
+	}, nil
+}
+
+func (i BDDInput) Validate() map[string]string {
+	if errs := validateBDDSource("", i.Formulas, i.BDD); errs != nil {
+		return errs
+	}
+	if i.Operand != nil {
+		return validateBDDSource("operand.", i.Operand.Formulas, i.Operand.BDD)
+	}
+	return nil
+}
+
+func validateBDDSource(prefix string, formulas []Formula, bdd *BDD) map[string]string {
+	if len(formulas) == 0 && bdd == nil {
+		return map[string]string{prefix + "formulas": "either formulas or a BDD are required"}
+	}
+	if len(formulas) > 0 && bdd != nil {
+		return map[string]string{prefix + "bdd": "formulas and a BDD cannot be given at the same time"}
+	}
+	for _, f := range formulas {
+		if f.Empty() {
+			return map[string]string{prefix + "formulas": "contains empty formula"}
+		}
+	}
+	return nil
+}
+
+func formulasToPB(formulas []Formula) []*pb.Formula {
+	result := make([]*pb.Formula, len(formulas))
+	for i, f := range formulas {
+		result[i] = f.ProtoBuf()
+	}
+	return result
+}
+
+func formulasFromPB(formulas []*pb.Formula) []Formula {
+	result := make([]Formula, len(formulas))
+	for i, f := range formulas {
+		result[i] = formulaFromPB(f)
+	}
+	return result
+}
+
+type BDDOrdering struct {
+	Ordering  string   `json:"ordering" example:"force"`
+	Order     []string `json:"order" example:"A,B,C"`
+	NodeCount int64    `json:"nodeCount" example:"3"`
+}
+
+type BDDOrderingResult struct {
+	State     ComputationState `json:"state"`
+	Orderings []BDDOrdering    `json:"orderings"`
+}
+
+func (i BDDCompilationInput) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.BDDCompilationInput{Formulas: formulasToPB(i.Formulas), Order: i.Order})
+}
+
+func (BDDCompilationInput) DeserProtoBuf(data []byte) (BDDCompilationInput, error) {
+	input := &pb.BDDCompilationInput{}
+	if err := proto.Unmarshal(data, input); err != nil {
+		return BDDCompilationInput{}, err
+	}
+	return BDDCompilationInput{formulasFromPB(input.Formulas), input.Order}, nil
+}
+
+func (BDDCompilationInput) DeserOPB(data []byte) (BDDCompilationInput, error) {
+	input, err := FormulaInput{}.DeserOPB(data)
+	return BDDCompilationInput{Formulas: input.Formulas}, err
+}
+
+func (BDDCompilationInput) DeserSMT2(data []byte) (BDDCompilationInput, error) {
+	input, err := FormulaInput{}.DeserSMT2(data)
+	return BDDCompilationInput{Formulas: input.Formulas}, err
+}
+
+func (BDDCompilationInput) DeserAIGER(data []byte) (BDDCompilationInput, error) {
+	input, err := FormulaInput{}.DeserAIGER(data)
+	return BDDCompilationInput{Formulas: input.Formulas}, err
+}
+
+func (BDDCompilationInput) DeserNNF(data []byte) (BDDCompilationInput, error) {
+	input, err := FormulaInput{}.DeserNNF(data)
+	return BDDCompilationInput{Formulas: input.Formulas}, err
+}
+
+func (i BDDCompilationInput) Validate() map[string]string {
+	if errs := (FormulaInput{Formulas: i.Formulas}).Validate(); errs != nil {
+		return errs
+	}
+	seen := make(map[string]bool, len(i.Order))
+	for _, name := range i.Order {
+		if seen[name] {
+			return map[string]string{"order": "contains duplicate variable " + name}
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+func (r BDDResult) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.BDDResult{State: r.State.toPB(), Bdd: r.BDD.ProtoBuf()})
+}
+
+func (BDDResult) DeserProtoBuf(data []byte) (BDDResult, error) {
+	result := &pb.BDDResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return BDDResult{}, err
+	}
+	return BDDResult{stateFromPB(result.State), bddFromPB(result.Bdd)}, nil
+}
+
+func WriteBDDResult(w http.ResponseWriter, r *http.Request, bdd *BDD) {
+	result := BDDResult{
+		State: successState(r),
+		BDD:   bdd,
+	}
+	WriteResult(w, r, result)
+}
+
+func (r BDDOrderingResult) ProtoBuf() ([]byte, error) {
+	orderings := make([]*pb.BDDOrdering, len(r.Orderings))
+	for i, o := range r.Orderings {
+		orderings[i] = &pb.BDDOrdering{Ordering: o.Ordering, Order: o.Order, NodeCount: o.NodeCount}
+	}
+	return proto.Marshal(&pb.BDDOrderingResult{State: r.State.toPB(), Orderings: orderings})
+}
+
+func (BDDOrderingResult) DeserProtoBuf(data []byte) (BDDOrderingResult, error) {
+	result := &pb.BDDOrderingResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return BDDOrderingResult{}, err
+	}
+	orderings := make([]BDDOrdering, len(result.Orderings))
+	for i, o := range result.Orderings {
+		orderings[i] = BDDOrdering{o.Ordering, o.Order, o.NodeCount}
+	}
+	return BDDOrderingResult{stateFromPB(result.State), orderings}, nil
+}
+
+func WriteBDDOrderingResult(w http.ResponseWriter, r *http.Request, orderings []BDDOrdering) {
+	result := BDDOrderingResult{
+		State:     successState(r),
+		Orderings: orderings,
+	}
+	WriteResult(w, r, result)
+}
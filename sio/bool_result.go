@@ -29,7 +29,7 @@ func (BoolResult) DeserProtoBuf(data []byte) (BoolResult, error) {
 
 func WriteBoolResult(w http.ResponseWriter, r *http.Request, value bool) {
 	result := BoolResult{
-		State: ComputationState{Success: true},
+		State: successState(r),
 		Value: value,
 	}
 	WriteResult(w, r, result)
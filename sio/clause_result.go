@@ -0,0 +1,75 @@
+package sio
+
+import (
+	"net/http"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+type ClauseResult struct {
+	State     ComputationState `json:"state"`
+	Variables []string         `json:"variables,omitempty" example:"A,B,C"`
+	Formulas  []ClauseSet      `json:"formulas,omitempty"`
+}
+
+type ClauseSet struct {
+	Description string     `json:"description,omitempty" example:"description text"`
+	Clauses     [][]string `json:"clauses,omitempty"`
+	IntClauses  [][]int32  `json:"intClauses,omitempty"`
+}
+
+func (r ClauseResult) ProtoBuf() ([]byte, error) {
+	formulas := make([]*pb.ClauseSet, len(r.Formulas))
+	for i, f := range r.Formulas {
+		clauses := make([]*pb.Clause, len(f.Clauses))
+		for j, c := range f.Clauses {
+			clauses[j] = &pb.Clause{Literals: c}
+		}
+		intClauses := make([]*pb.IntClause, len(f.IntClauses))
+		for j, c := range f.IntClauses {
+			intClauses[j] = &pb.IntClause{Literals: c}
+		}
+		formulas[i] = &pb.ClauseSet{Description: f.Description, Clauses: clauses, IntClauses: intClauses}
+	}
+	return proto.Marshal(&pb.ClauseResult{
+		State:     r.State.toPB(),
+		Variables: r.Variables,
+		Formulas:  formulas,
+	})
+}
+
+func (ClauseResult) DeserProtoBuf(data []byte) (ClauseResult, error) {
+	result := &pb.ClauseResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return ClauseResult{}, err
+	}
+	formulas := make([]ClauseSet, len(result.Formulas))
+	for i, f := range result.Formulas {
+		var clauses [][]string
+		if len(f.Clauses) > 0 {
+			clauses = make([][]string, len(f.Clauses))
+			for j, c := range f.Clauses {
+				clauses[j] = c.Literals
+			}
+		}
+		var intClauses [][]int32
+		if len(f.IntClauses) > 0 {
+			intClauses = make([][]int32, len(f.IntClauses))
+			for j, c := range f.IntClauses {
+				intClauses[j] = c.Literals
+			}
+		}
+		formulas[i] = ClauseSet{f.Description, clauses, intClauses}
+	}
+	return ClauseResult{stateFromPB(result.State), result.Variables, formulas}, nil
+}
+
+func WriteClauseResult(w http.ResponseWriter, r *http.Request, variables []string, formulas ...ClauseSet) {
+	result := ClauseResult{
+		State:     successState(r),
+		Variables: variables,
+		Formulas:  formulas,
+	}
+	WriteResult(w, r, result)
+}
@@ -36,7 +36,7 @@ func (ComponentResult) DeserProtoBuf(data []byte) (ComponentResult, error) {
 	for i, c := range result.Components {
 		formulas := make([]Formula, len(c.Formulas))
 		for j, f := range c.Formulas {
-			formulas[j] = Formula{f.Formula, f.Description}
+			formulas[j] = formulaFromPB(f)
 		}
 		components[i] = formulas
 	}
@@ -45,7 +45,7 @@ func (ComponentResult) DeserProtoBuf(data []byte) (ComponentResult, error) {
 
 func WriteComponentResult(w http.ResponseWriter, r *http.Request, components [][]Formula) {
 	result := ComponentResult{
-		State:      ComputationState{Success: true},
+		State:      successState(r),
 		Components: components,
 	}
 	WriteResult(w, r, result)
@@ -0,0 +1,109 @@
+package sio
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// Cube is a partial assignment of the variables of a CubeResult.  Unassigned
+// variables are don't cares, the count is the number of full models the cube
+// covers.
+type Cube struct {
+	Literals []string `json:"literals" example:"A,~C"`
+	Count    string   `json:"count" example:"2"`
+}
+
+// CubeResult holds disjoint cubes covering all models.  In the ProtoBuf
+// encoding each cube is a pair of bitsets over the variables.  If the formulas
+// are unsatisfiable under their assumptions, there are no cubes and the
+// failed assumptions are given.
+type CubeResult struct {
+	State             ComputationState `json:"state"`
+	Variables         []string         `json:"variables" example:"A,B,C"`
+	Cubes             []Cube           `json:"cubes"`
+	FailedAssumptions []string         `json:"failedAssumptions,omitempty" example:"~B"`
+}
+
+func (r CubeResult) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.CubeResult{
+		State:             r.State.toPB(),
+		Variables:         r.Variables,
+		Cubes:             cubesToPB(r.Variables, r.Cubes),
+		FailedAssumptions: r.FailedAssumptions,
+	})
+}
+
+func (CubeResult) DeserProtoBuf(data []byte) (CubeResult, error) {
+	result := &pb.CubeResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return CubeResult{}, err
+	}
+	return CubeResult{
+		stateFromPB(result.State),
+		result.Variables,
+		cubesFromPB(result.Variables, result.Cubes),
+		result.FailedAssumptions,
+	}, nil
+}
+
+func cubesToPB(variables []string, cubes []Cube) []*pb.Cube {
+	index := make(map[string]int, len(variables))
+	for i, v := range variables {
+		index[v] = i
+	}
+	size := (len(variables) + 7) / 8
+	result := make([]*pb.Cube, len(cubes))
+	for i, cube := range cubes {
+		mask, phases := make([]byte, size), make([]byte, size)
+		for _, lit := range cube.Literals {
+			name, negative := strings.CutPrefix(lit, "~")
+			pos := index[name]
+			mask[pos/8] |= 1 << (pos % 8)
+			if !negative {
+				phases[pos/8] |= 1 << (pos % 8)
+			}
+		}
+		result[i] = &pb.Cube{Mask: mask, Phases: phases, Count: cube.Count}
+	}
+	return result
+}
+
+func cubesFromPB(variables []string, cubes []*pb.Cube) []Cube {
+	result := make([]Cube, len(cubes))
+	for i, cube := range cubes {
+		literals := []string{}
+		for pos, name := range variables {
+			if pos/8 >= len(cube.Mask) || cube.Mask[pos/8]&(1<<(pos%8)) == 0 {
+				continue
+			}
+			if cube.Phases[pos/8]&(1<<(pos%8)) == 0 {
+				name = "~" + name
+			}
+			literals = append(literals, name)
+		}
+		result[i] = Cube{literals, cube.Count}
+	}
+	return result
+}
+
+func WriteCubeResult(w http.ResponseWriter, r *http.Request, variables []string, cubes []Cube) {
+	result := CubeResult{
+		State:     successState(r),
+		Variables: variables,
+		Cubes:     cubes,
+	}
+	WriteResult(w, r, result)
+}
+
+func WriteUnsatCubeResult(w http.ResponseWriter, r *http.Request, variables []string, failedAssumptions []string) {
+	result := CubeResult{
+		State:             successState(r),
+		Variables:         variables,
+		Cubes:             []Cube{},
+		FailedAssumptions: failedAssumptions,
+	}
+	WriteResult(w, r, result)
+}
@@ -0,0 +1,47 @@
+package sio
+
+import (
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// DiffInput holds two versions of a rule base.  The solver config is used for
+// all SAT solvers of the computation.
+type DiffInput struct {
+	Old          []Formula     `json:"old"`
+	New          []Formula     `json:"new"`
+	SolverConfig *SolverConfig `json:"solverConfig,omitempty"`
+}
+
+func (i DiffInput) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.DiffInput{
+		Old:          formulasToPB(i.Old),
+		New:          formulasToPB(i.New),
+		SolverConfig: i.SolverConfig.toPB(),
+	})
+}
+
+func (DiffInput) DeserProtoBuf(data []byte) (DiffInput, error) {
+	input := &pb.DiffInput{}
+	if err := proto.Unmarshal(data, input); err != nil {
+		return DiffInput{}, err
+	}
+	return DiffInput{formulasFromPB(input.Old), formulasFromPB(input.New), solverConfigFromPB(input.SolverConfig)}, nil
+}
+
+func (i DiffInput) Validate() map[string]string {
+	if len(i.Old) == 0 {
+		return map[string]string{"old": "empty formula list"}
+	}
+	if len(i.New) == 0 {
+		return map[string]string{"new": "empty formula list"}
+	}
+	for _, fs := range [][]Formula{i.Old, i.New} {
+		for _, f := range fs {
+			if f.Empty() {
+				return map[string]string{"formulas": "contains empty formula"}
+			}
+		}
+	}
+	return i.SolverConfig.validate()
+}
@@ -0,0 +1,98 @@
+package sio
+
+import (
+	"net/http"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// DiffResult holds the semantic difference of two rule base versions over
+// the variables of both versions.  The added and removed formulas are those
+// which occur in only one version.  The causes of gained models are removed
+// formulas, the causes of lost models are added formulas.  Dead variables are
+// false and forced variables are true in all models of the new version, but
+// not of the old version.
+type DiffResult struct {
+	State     ComputationState `json:"state"`
+	Variables []string         `json:"variables" example:"A,B,C"`
+	Added     []Formula        `json:"added,omitempty"`
+	Removed   []Formula        `json:"removed,omitempty"`
+	Gained    ModelDiff        `json:"gained"`
+	Lost      ModelDiff        `json:"lost"`
+	Backbone  []BackboneChange `json:"backbone,omitempty"`
+	Dead      []string         `json:"dead,omitempty" example:"B"`
+	Forced    []string         `json:"forced,omitempty" example:"A"`
+}
+
+// ModelDiff holds the number of models which are only in one version, some
+// of them as cubes, and the changed formulas which cause them.
+type ModelDiff struct {
+	Count  string    `json:"count" example:"4"`
+	Cubes  []Cube    `json:"cubes,omitempty"`
+	Causes []Formula `json:"causes,omitempty"`
+}
+
+// BackboneChange is a literal which is 'added' to or 'removed' from the
+// backbone together with a minimal set of changed formulas which cause it.
+type BackboneChange struct {
+	Literal string    `json:"literal" example:"~B"`
+	Change  string    `json:"change" enums:"added,removed"`
+	Causes  []Formula `json:"causes,omitempty"`
+}
+
+func (r DiffResult) ProtoBuf() ([]byte, error) {
+	backbone := make([]*pb.BackboneChange, len(r.Backbone))
+	for i, c := range r.Backbone {
+		backbone[i] = &pb.BackboneChange{Literal: c.Literal, Change: c.Change, Causes: formulasToPB(c.Causes)}
+	}
+	return proto.Marshal(&pb.DiffResult{
+		State:     r.State.toPB(),
+		Variables: r.Variables,
+		Added:     formulasToPB(r.Added),
+		Removed:   formulasToPB(r.Removed),
+		Gained:    r.Gained.toPB(r.Variables),
+		Lost:      r.Lost.toPB(r.Variables),
+		Backbone:  backbone,
+		Dead:      r.Dead,
+		Forced:    r.Forced,
+	})
+}
+
+func (DiffResult) DeserProtoBuf(data []byte) (DiffResult, error) {
+	result := &pb.DiffResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return DiffResult{}, err
+	}
+	backbone := make([]BackboneChange, len(result.Backbone))
+	for i, c := range result.Backbone {
+		backbone[i] = BackboneChange{c.Literal, c.Change, formulasFromPB(c.Causes)}
+	}
+	return DiffResult{
+		State:     stateFromPB(result.State),
+		Variables: result.Variables,
+		Added:     formulasFromPB(result.Added),
+		Removed:   formulasFromPB(result.Removed),
+		Gained:    modelDiffFromPB(result.Variables, result.Gained),
+		Lost:      modelDiffFromPB(result.Variables, result.Lost),
+		Backbone:  backbone,
+		Dead:      result.Dead,
+		Forced:    result.Forced,
+	}, nil
+}
+
+func (d ModelDiff) toPB(variables []string) *pb.ModelDiff {
+	return &pb.ModelDiff{Count: d.Count, Cubes: cubesToPB(variables, d.Cubes), Causes: formulasToPB(d.Causes)}
+}
+
+func modelDiffFromPB(variables []string, d *pb.ModelDiff) ModelDiff {
+	if d == nil {
+		return ModelDiff{}
+	}
+	return ModelDiff{d.Count, cubesFromPB(variables, d.Cubes), formulasFromPB(d.Causes)}
+}
+
+func WriteDiffResult(w http.ResponseWriter, r *http.Request, result DiffResult) {
+	result.State = successState(r)
+	WriteResult(w, r, result)
+}
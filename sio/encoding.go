@@ -6,9 +6,11 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 )
 
 func Unmarshal[T ServiceInput[T]](r *http.Request) (object T, sErr ServiceError) {
+	defer StatsOf(r).AddParseTime(time.Now())
 	switch ct := r.Header.Get("Content-Type"); ct {
 	case "", "application/json":
 		err := json.NewDecoder(r.Body).Decode(&object)
@@ -25,6 +27,66 @@ func Unmarshal[T ServiceInput[T]](r *http.Request) (object T, sErr ServiceError)
 		if err != nil {
 			sErr = ErrIllegalInput(err)
 		}
+	case "application/opb":
+		reader, ok := any(object).(opbInput[T])
+		if !ok {
+			sErr = ErrUnsupportedContentType(ct)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			sErr = ErrIllegalInput(err)
+			return
+		}
+		object, err = reader.DeserOPB(data)
+		if err != nil {
+			sErr = ErrIllegalInput(err)
+		}
+	case "application/smt2":
+		reader, ok := any(object).(smt2Input[T])
+		if !ok {
+			sErr = ErrUnsupportedContentType(ct)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			sErr = ErrIllegalInput(err)
+			return
+		}
+		object, err = reader.DeserSMT2(data)
+		if err != nil {
+			sErr = ErrIllegalInput(err)
+		}
+	case "application/aiger":
+		reader, ok := any(object).(aigerInput[T])
+		if !ok {
+			sErr = ErrUnsupportedContentType(ct)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			sErr = ErrIllegalInput(err)
+			return
+		}
+		object, err = reader.DeserAIGER(data)
+		if err != nil {
+			sErr = ErrIllegalInput(err)
+		}
+	case "application/nnf":
+		reader, ok := any(object).(nnfInput[T])
+		if !ok {
+			sErr = ErrUnsupportedContentType(ct)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			sErr = ErrIllegalInput(err)
+			return
+		}
+		object, err = reader.DeserNNF(data)
+		if err != nil {
+			sErr = ErrIllegalInput(err)
+		}
 	default:
 		sErr = ErrUnsupportedContentType(ct)
 	}
@@ -35,6 +97,7 @@ func Unmarshal[T ServiceInput[T]](r *http.Request) (object T, sErr ServiceError)
 }
 
 func WriteResult[T ServiceOutput[T]](w http.ResponseWriter, r *http.Request, object T) {
+	StatsOf(r).finish()
 	var err error
 	switch acc := r.Header.Get("accept"); acc {
 	case "", "*/*", "application/json":
@@ -69,6 +132,15 @@ func WriteTextResult(w http.ResponseWriter, r *http.Request, text string) {
 	}
 }
 
+func WriteBinaryResult(w http.ResponseWriter, r *http.Request, contentType string, data []byte) {
+	w.Header().Add("Content-Type", contentType)
+	_, err := w.Write(data)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("Internal Server Error"))
+	}
+}
+
 func formatValidationErrors(errors map[string]string) string {
 	var sb strings.Builder
 	sb.WriteString("validation errors ")
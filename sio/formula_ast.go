@@ -0,0 +1,48 @@
+package sio
+
+import "github.com/booleworks/logicng-service/sio/pb"
+
+type FormulaAST struct {
+	Op           string       `json:"op" example:"and" enums:"true,false,var,not,impl,equiv,and,or,cc,pbc"`
+	Name         string       `json:"name,omitempty" example:"A"`
+	Ops          []FormulaAST `json:"ops,omitempty"`
+	Vars         []string     `json:"vars,omitempty" example:"A,B,C"`
+	Lits         []string     `json:"lits,omitempty" example:"A,~B"`
+	Coefficients []int64      `json:"coefficients,omitempty" example:"2,3"`
+	Comparator   string       `json:"comparator,omitempty" example:"<=" enums:"=,<=,<,>=,>"`
+	RHS          int64        `json:"rhs,omitempty" example:"2"`
+}
+
+func (a *FormulaAST) ProtoBuf() *pb.FormulaAST {
+	if a == nil {
+		return nil
+	}
+	ops := make([]*pb.FormulaAST, len(a.Ops))
+	for i := range a.Ops {
+		ops[i] = a.Ops[i].ProtoBuf()
+	}
+	return &pb.FormulaAST{
+		Op:           a.Op,
+		Name:         a.Name,
+		Ops:          ops,
+		Vars:         a.Vars,
+		Lits:         a.Lits,
+		Coefficients: a.Coefficients,
+		Comparator:   a.Comparator,
+		Rhs:          a.RHS,
+	}
+}
+
+func astFromPB(a *pb.FormulaAST) *FormulaAST {
+	if a == nil {
+		return nil
+	}
+	var ops []FormulaAST
+	if len(a.Ops) > 0 {
+		ops = make([]FormulaAST, len(a.Ops))
+		for i, op := range a.Ops {
+			ops[i] = *astFromPB(op)
+		}
+	}
+	return &FormulaAST{a.Op, a.Name, ops, a.Vars, a.Lits, a.Coefficients, a.Comparator, a.Rhs}
+}
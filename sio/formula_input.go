@@ -1,19 +1,32 @@
 package sio
 
 import (
+	"encoding/json"
 	"strings"
 
+	"github.com/booleworks/logicng-go/formula"
 	"github.com/booleworks/logicng-service/sio/pb"
 	"google.golang.org/protobuf/proto"
 )
 
 type Formula struct {
-	Formula     string `json:"formula" example:"~(A & B) => C | ~D"`
-	Description string `json:"description,omitempty" example:"description text"`
+	Formula     string      `json:"formula,omitempty" example:"~(A & B) => C | ~D"`
+	Description string      `json:"description,omitempty" example:"description text"`
+	AST         *FormulaAST `json:"ast,omitempty"`
+
+	// build constructs the formula directly on a factory.  It is set by the
+	// input formats whose formulas share subformulas, which a syntax tree
+	// would have to copy for each parent.
+	build func(fac formula.Factory) formula.Formula
 }
 
+// FormulaInput holds a list of formulas.  The assumptions are literals which
+// are assumed to hold by the solver, counting, and enumeration endpoints.  The
+// solver config is used for all SAT solvers of the computation.
 type FormulaInput struct {
-	Formulas []Formula `json:"formulas"`
+	Formulas     []Formula     `json:"formulas"`
+	Assumptions  []string      `json:"assumptions,omitempty" example:"A,~B"`
+	SolverConfig *SolverConfig `json:"solverConfig,omitempty"`
 }
 
 func (i FormulaInput) ProtoBuf() ([]byte, error) {
@@ -21,7 +34,11 @@ func (i FormulaInput) ProtoBuf() ([]byte, error) {
 	for i, f := range i.Formulas {
 		formulas[i] = f.ProtoBuf()
 	}
-	return proto.Marshal(&pb.FormulaInput{Formulas: formulas})
+	return proto.Marshal(&pb.FormulaInput{
+		Formulas:     formulas,
+		Assumptions:  i.Assumptions,
+		SolverConfig: i.SolverConfig.toPB(),
+	})
 }
 
 func (FormulaInput) DeserProtoBuf(data []byte) (FormulaInput, error) {
@@ -31,13 +48,38 @@ func (FormulaInput) DeserProtoBuf(data []byte) (FormulaInput, error) {
 	}
 	formulas := make([]Formula, len(input.Formulas))
 	for i, f := range input.Formulas {
-		formulas[i] = Formula{f.Formula, f.Description}
+		formulas[i] = formulaFromPB(f)
 	}
-	return FormulaInput{formulas}, nil
+	return FormulaInput{formulas, input.Assumptions, solverConfigFromPB(input.SolverConfig)}, nil
 }
 
 func (f Formula) ProtoBuf() *pb.Formula {
-	return &pb.Formula{Formula: f.Formula, Description: f.Description}
+	return &pb.Formula{Formula: f.Formula, Description: f.Description, Ast: f.AST.ProtoBuf()}
+}
+
+func formulaFromPB(f *pb.Formula) Formula {
+	return Formula{Formula: f.Formula, Description: f.Description, AST: astFromPB(f.Ast)}
+}
+
+func (f Formula) String() string {
+	if f.AST != nil {
+		ast, _ := json.Marshal(f.AST)
+		return string(ast)
+	}
+	return f.Formula
+}
+
+// Build constructs the formula on the factory if it was read from an input
+// format with shared subformulas.
+func (f Formula) Build(fac formula.Factory) (formula.Formula, bool) {
+	if f.build == nil {
+		return 0, false
+	}
+	return f.build(fac), true
+}
+
+func (f Formula) Empty() bool {
+	return f.build == nil && f.AST == nil && strings.TrimSpace(f.Formula) == ""
 }
 
 func (i FormulaInput) Validate() map[string]string {
@@ -45,9 +87,9 @@ func (i FormulaInput) Validate() map[string]string {
 		return map[string]string{"formulas": "empty formula list"}
 	}
 	for _, f := range i.Formulas {
-		if strings.TrimSpace(f.Formula) == "" {
+		if f.Empty() {
 			return map[string]string{"formulas": "contains empty formula"}
 		}
 	}
-	return nil
+	return i.SolverConfig.validate()
 }
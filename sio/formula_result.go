@@ -8,8 +8,9 @@ import (
 )
 
 type FormulaResult struct {
-	State    ComputationState `json:"state"`
-	Formulas []Formula        `json:"formulas,omitempty"`
+	State             ComputationState `json:"state"`
+	Formulas          []Formula        `json:"formulas,omitempty"`
+	FailedAssumptions []string         `json:"failedAssumptions,omitempty" example:"~B"`
 }
 
 func (r FormulaResult) ProtoBuf() ([]byte, error) {
@@ -18,8 +19,9 @@ func (r FormulaResult) ProtoBuf() ([]byte, error) {
 		formulas[i] = f.ProtoBuf()
 	}
 	return proto.Marshal(&pb.FormulaResult{
-		State:    r.State.toPB(),
-		Formulas: formulas,
+		State:             r.State.toPB(),
+		Formulas:          formulas,
+		FailedAssumptions: r.FailedAssumptions,
 	})
 }
 
@@ -30,15 +32,25 @@ func (FormulaResult) DeserProtoBuf(data []byte) (FormulaResult, error) {
 	}
 	formulas := make([]Formula, len(result.Formulas))
 	for i, f := range result.Formulas {
-		formulas[i] = Formula{f.Formula, f.Description}
+		formulas[i] = formulaFromPB(f)
 	}
-	return FormulaResult{stateFromPB(result.State), formulas}, nil
+	return FormulaResult{stateFromPB(result.State), formulas, result.FailedAssumptions}, nil
 }
 
 func WriteFormulaResult(w http.ResponseWriter, r *http.Request, formula ...Formula) {
 	result := FormulaResult{
-		State:    ComputationState{Success: true},
+		State:    successState(r),
 		Formulas: formula,
 	}
 	WriteResult(w, r, result)
 }
+
+// WriteUnsatFormulaResult writes an empty result for formulas which are
+// unsatisfiable under their assumptions together with the failed assumptions.
+func WriteUnsatFormulaResult(w http.ResponseWriter, r *http.Request, failedAssumptions []string) {
+	result := FormulaResult{
+		State:             successState(r),
+		FailedAssumptions: failedAssumptions,
+	}
+	WriteResult(w, r, result)
+}
@@ -1,23 +1,34 @@
 package sio
 
 import (
-	"strings"
-
 	"github.com/booleworks/logicng-service/sio/pb"
 	"google.golang.org/protobuf/proto"
 )
 
+// FormulaVarsInput holds formulas and variables.  The additional variables
+// are only used by the model enumeration: their values are reported in each
+// model, but not enumerated over.  The assumptions are literals which are
+// assumed to hold, the solver config is used for all SAT solvers.
 type FormulaVarsInput struct {
-	Formulas  []Formula `json:"formulas"`
-	Variables []string  `json:"variables" example:"A,C,E"`
+	Formulas            []Formula     `json:"formulas"`
+	Variables           []string      `json:"variables" example:"A,C,E"`
+	AdditionalVariables []string      `json:"additionalVariables,omitempty" example:"B"`
+	Assumptions         []string      `json:"assumptions,omitempty" example:"D"`
+	SolverConfig        *SolverConfig `json:"solverConfig,omitempty"`
 }
 
 func (i FormulaVarsInput) ProtoBuf() (bin []byte, err error) {
 	formulas := make([]*pb.Formula, len(i.Formulas))
 	for i, f := range i.Formulas {
-		formulas[i] = &pb.Formula{Formula: f.Formula, Description: f.Description}
+		formulas[i] = f.ProtoBuf()
 	}
-	bin, err = proto.Marshal(&pb.FormulaVarsInput{Formulas: formulas, Vars: i.Variables})
+	bin, err = proto.Marshal(&pb.FormulaVarsInput{
+		Formulas:       formulas,
+		Vars:           i.Variables,
+		AdditionalVars: i.AdditionalVariables,
+		Assumptions:    i.Assumptions,
+		SolverConfig:   i.SolverConfig.toPB(),
+	})
 	return
 }
 
@@ -28,9 +39,11 @@ func (FormulaVarsInput) DeserProtoBuf(data []byte) (FormulaVarsInput, error) {
 	}
 	formulas := make([]Formula, len(input.Formulas))
 	for i, f := range input.Formulas {
-		formulas[i] = Formula{f.Formula, f.Description}
+		formulas[i] = formulaFromPB(f)
 	}
-	return FormulaVarsInput{formulas, input.Vars}, nil
+	return FormulaVarsInput{
+		formulas, input.Vars, input.AdditionalVars, input.Assumptions, solverConfigFromPB(input.SolverConfig),
+	}, nil
 }
 
 func (i FormulaVarsInput) Validate() map[string]string {
@@ -38,12 +51,12 @@ func (i FormulaVarsInput) Validate() map[string]string {
 		return map[string]string{"formulas": "empty list"}
 	}
 	for _, f := range i.Formulas {
-		if strings.TrimSpace(f.Formula) == "" {
+		if f.Empty() {
 			return map[string]string{"formulas": "contains empty formula"}
 		}
 	}
 	if len(i.Variables) == 0 {
 		return map[string]string{"variables": "empty list"}
 	}
-	return nil
+	return i.SolverConfig.validate()
 }
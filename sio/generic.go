@@ -1,6 +1,8 @@
 package sio
 
 import (
+	"net/http"
+
 	"github.com/booleworks/logicng-service/sio/pb"
 	"google.golang.org/protobuf/proto"
 )
@@ -40,15 +42,22 @@ func (ComputationResult) DeserProtoBuf(data []byte) (ComputationResult, error) {
 type ComputationState struct {
 	Success bool   `json:"success"`
 	Error   string `json:"error,omitempty" example:""`
+	Stats   *Stats `json:"stats,omitempty"`
+}
+
+// successState returns the state of a successful computation of the request.
+func successState(r *http.Request) ComputationState {
+	return ComputationState{Success: true, Stats: StatsOf(r)}
 }
 
 func (c ComputationState) toPB() *pb.ComputationState {
 	return &pb.ComputationState{
 		Success: c.Success,
 		Error:   c.Error,
+		Stats:   c.Stats.toPB(),
 	}
 }
 
 func stateFromPB(bin *pb.ComputationState) ComputationState {
-	return ComputationState{bin.Success, bin.Error}
+	return ComputationState{bin.Success, bin.Error, statsFromPB(bin.Stats)}
 }
@@ -58,7 +58,7 @@ func (GraphResult) DeserProtoBuf(data []byte) (GraphResult, error) {
 
 func WriteGraphResult(w http.ResponseWriter, r *http.Request, nodes []Node, edges []Edge) {
 	result := GraphResult{
-		State: ComputationState{Success: true},
+		State: successState(r),
 		Nodes: nodes,
 		Edges: edges,
 	}
@@ -30,7 +30,7 @@ func (IntResult) DeserProtoBuf(data []byte) (IntResult, error) {
 
 func WriteIntResult(w http.ResponseWriter, r *http.Request, value int64) {
 	result := IntResult{
-		State: ComputationState{Success: true},
+		State: successState(r),
 		Value: value,
 	}
 	WriteResult(w, r, result)
@@ -0,0 +1,40 @@
+package sio
+
+import (
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// MarginalsInput holds the formulas, the variables for which the marginals are
+// computed, and an optional projection.  Without variables the marginals of
+// all projection variables are computed, without projection the models range
+// over all variables of the formulas.
+type MarginalsInput struct {
+	Formulas     []Formula     `json:"formulas"`
+	Variables    []string      `json:"variables,omitempty" example:"A,B"`
+	Projection   []string      `json:"projection,omitempty" example:"A,B,C"`
+	SolverConfig *SolverConfig `json:"solverConfig,omitempty"`
+}
+
+func (i MarginalsInput) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.MarginalsInput{
+		Formulas:     formulasToPB(i.Formulas),
+		Variables:    i.Variables,
+		Projection:   i.Projection,
+		SolverConfig: i.SolverConfig.toPB(),
+	})
+}
+
+func (MarginalsInput) DeserProtoBuf(data []byte) (MarginalsInput, error) {
+	input := &pb.MarginalsInput{}
+	if err := proto.Unmarshal(data, input); err != nil {
+		return MarginalsInput{}, err
+	}
+	return MarginalsInput{
+		formulasFromPB(input.Formulas), input.Variables, input.Projection, solverConfigFromPB(input.SolverConfig),
+	}, nil
+}
+
+func (i MarginalsInput) Validate() map[string]string {
+	return FormulaInput{Formulas: i.Formulas, SolverConfig: i.SolverConfig}.Validate()
+}
@@ -0,0 +1,51 @@
+package sio
+
+import (
+	"net/http"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// Marginal holds the number of models in which a variable is true and their
+// ratio to all models.
+type Marginal struct {
+	Variable string  `json:"variable" example:"A"`
+	Count    string  `json:"count" example:"3"`
+	Ratio    float64 `json:"ratio" example:"0.75"`
+}
+
+type MarginalsResult struct {
+	State     ComputationState `json:"state"`
+	Count     string           `json:"count" example:"4"`
+	Marginals []Marginal       `json:"marginals"`
+}
+
+func (r MarginalsResult) ProtoBuf() ([]byte, error) {
+	marginals := make([]*pb.Marginal, len(r.Marginals))
+	for i, m := range r.Marginals {
+		marginals[i] = &pb.Marginal{Variable: m.Variable, Count: m.Count, Ratio: m.Ratio}
+	}
+	return proto.Marshal(&pb.MarginalsResult{State: r.State.toPB(), Count: r.Count, Marginals: marginals})
+}
+
+func (MarginalsResult) DeserProtoBuf(data []byte) (MarginalsResult, error) {
+	result := &pb.MarginalsResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return MarginalsResult{}, err
+	}
+	marginals := make([]Marginal, len(result.Marginals))
+	for i, m := range result.Marginals {
+		marginals[i] = Marginal{m.Variable, m.Count, m.Ratio}
+	}
+	return MarginalsResult{stateFromPB(result.State), result.Count, marginals}, nil
+}
+
+func WriteMarginalsResult(w http.ResponseWriter, r *http.Request, count string, marginals []Marginal) {
+	result := MarginalsResult{
+		State:     successState(r),
+		Count:     count,
+		Marginals: marginals,
+	}
+	WriteResult(w, r, result)
+}
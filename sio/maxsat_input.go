@@ -11,14 +11,15 @@ import (
 type MaxSatInput struct {
 	HardFormulas []Formula        `json:"hardFormulas"`
 	SoftFormulas map[string]int64 `json:"softFormulas" example:"~A:3,~B:4,~C & D:2"`
+	Offset       int64            `json:"offset,omitempty" example:"0"`
 }
 
 func (i MaxSatInput) ProtoBuf() (bin []byte, err error) {
 	hardFormulas := make([]*pb.Formula, len(i.HardFormulas))
 	for i, f := range i.HardFormulas {
-		hardFormulas[i] = &pb.Formula{Formula: f.Formula, Description: f.Description}
+		hardFormulas[i] = f.ProtoBuf()
 	}
-	bin, err = proto.Marshal(&pb.MaxSatInput{HardFormulas: hardFormulas, SoftFormulas: i.SoftFormulas})
+	bin, err = proto.Marshal(&pb.MaxSatInput{HardFormulas: hardFormulas, SoftFormulas: i.SoftFormulas, Offset: i.Offset})
 	return
 }
 
@@ -29,9 +30,9 @@ func (MaxSatInput) DeserProtoBuf(data []byte) (MaxSatInput, error) {
 	}
 	hardFormulas := make([]Formula, len(input.HardFormulas))
 	for i, f := range input.HardFormulas {
-		hardFormulas[i] = Formula{f.Formula, f.Description}
+		hardFormulas[i] = formulaFromPB(f)
 	}
-	return MaxSatInput{hardFormulas, input.SoftFormulas}, nil
+	return MaxSatInput{hardFormulas, input.SoftFormulas, input.Offset}, nil
 }
 
 func (i MaxSatInput) Validate() map[string]string {
@@ -40,7 +41,7 @@ func (i MaxSatInput) Validate() map[string]string {
 		return map[string]string{"softFormulas": "required field is empty"}
 	}
 	for _, f := range i.HardFormulas {
-		if strings.TrimSpace(f.Formula) == "" {
+		if f.Empty() {
 			return map[string]string{"hardFormulas": "contains empty formula"}
 		}
 	}
@@ -33,7 +33,7 @@ func (MaxSatResult) DeserProtoBuf(data []byte) (MaxSatResult, error) {
 
 func WriteMaxSatResult(w http.ResponseWriter, r *http.Request, sat bool, opt int64, model []string) {
 	result := MaxSatResult{
-		State:       ComputationState{Success: true},
+		State:       successState(r),
 		Satisfiable: sat,
 		Optimum:     opt,
 		Model:       model,
@@ -0,0 +1,69 @@
+package sio
+
+import (
+	"net/http"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ModelPageResult holds one page of an enumeration.  If the enumeration is
+// truncated, the next page is requested with the cursor.  The total number of
+// models is only given if it is cheap to compute.  If the formulas are
+// unsatisfiable under their assumptions, the page is empty and holds the
+// failed assumptions.
+type ModelPageResult struct {
+	State             ComputationState `json:"state"`
+	Models            []Formula        `json:"models"`
+	Truncated         bool             `json:"truncated"`
+	Cursor            string           `json:"cursor,omitempty" example:"YgIBAQ"`
+	Count             string           `json:"count,omitempty" example:"42"`
+	FailedAssumptions []string         `json:"failedAssumptions,omitempty" example:"~B"`
+}
+
+func (r ModelPageResult) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.ModelPageResult{
+		State:             r.State.toPB(),
+		Models:            formulasToPB(r.Models),
+		Truncated:         r.Truncated,
+		Cursor:            r.Cursor,
+		Count:             r.Count,
+		FailedAssumptions: r.FailedAssumptions,
+	})
+}
+
+func (ModelPageResult) DeserProtoBuf(data []byte) (ModelPageResult, error) {
+	result := &pb.ModelPageResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return ModelPageResult{}, err
+	}
+	return ModelPageResult{
+		stateFromPB(result.State),
+		formulasFromPB(result.Models),
+		result.Truncated,
+		result.Cursor,
+		result.Count,
+		result.FailedAssumptions,
+	}, nil
+}
+
+func WriteModelPageResult(w http.ResponseWriter, r *http.Request, models []Formula, cursor, count string) {
+	result := ModelPageResult{
+		State:     successState(r),
+		Models:    models,
+		Truncated: cursor != "",
+		Cursor:    cursor,
+		Count:     count,
+	}
+	WriteResult(w, r, result)
+}
+
+func WriteUnsatModelPageResult(w http.ResponseWriter, r *http.Request, failedAssumptions []string) {
+	result := ModelPageResult{
+		State:             successState(r),
+		Models:            []Formula{},
+		Count:             "0",
+		FailedAssumptions: failedAssumptions,
+	}
+	WriteResult(w, r, result)
+}
@@ -0,0 +1,134 @@
+package sio
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/booleworks/logicng-go/formula"
+)
+
+type nnfInput[T any] interface {
+	DeserNNF([]byte) (T, error)
+}
+
+// DeserNNF reads an NNF in the format of the c2d and d4 compilers.  Variable
+// names can be given as comment lines 'c <index> <name>', otherwise variables
+// are named 'v<index>'.  The result contains the root node as single formula.
+// Since the nodes of an NNF are shared, the formula is built directly on the
+// factory with one formula per node.
+func (FormulaInput) DeserNNF(data []byte) (FormulaInput, error) {
+	names := make(map[int]string)
+	var nodes []nnfNode
+	header := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "c" {
+			if len(fields) == 3 {
+				if index, err := strconv.Atoi(fields[1]); err == nil {
+					names[index] = fields[2]
+				}
+			}
+			continue
+		}
+		if !header {
+			if fields[0] != "nnf" || len(fields) != 4 {
+				return FormulaInput{}, fmt.Errorf("illegal NNF header '%s'", scanner.Text())
+			}
+			header = true
+			continue
+		}
+		values := make([]int, len(fields)-1)
+		for i, field := range fields[1:] {
+			value, err := strconv.Atoi(field)
+			if err != nil {
+				return FormulaInput{}, fmt.Errorf("illegal NNF node '%s'", scanner.Text())
+			}
+			values[i] = value
+		}
+		node, err := readNNFNode(fields[0], values, len(nodes))
+		if err != nil {
+			return FormulaInput{}, fmt.Errorf("illegal NNF node '%s': %w", scanner.Text(), err)
+		}
+		nodes = append(nodes, node)
+	}
+	if err := scanner.Err(); err != nil {
+		return FormulaInput{}, err
+	}
+	if len(nodes) == 0 {
+		return FormulaInput{}, fmt.Errorf("NNF input without nodes")
+	}
+	build := func(fac formula.Factory) formula.Formula {
+		return buildNNF(fac, nodes, names)
+	}
+	return FormulaInput{Formulas: []Formula{{build: build}}}, nil
+}
+
+// nnfNode is a node of an NNF.  A literal node holds its DIMACS literal, an
+// and or or node the indices of its children.
+type nnfNode struct {
+	kind     string
+	literal  int
+	children []int
+}
+
+func readNNFNode(kind string, values []int, numNodes int) (nnfNode, error) {
+	switch kind {
+	case "L":
+		if len(values) != 1 || values[0] == 0 {
+			return nnfNode{}, fmt.Errorf("literal node requires one non-zero literal")
+		}
+		return nnfNode{kind: kind, literal: values[0]}, nil
+	case "A", "O":
+		children := values
+		if kind == "O" {
+			if len(values) == 0 {
+				return nnfNode{}, fmt.Errorf("or node requires a decision variable")
+			}
+			children = values[1:]
+		}
+		if len(children) == 0 || children[0] != len(children)-1 {
+			return nnfNode{}, fmt.Errorf("wrong number of children")
+		}
+		for _, child := range children[1:] {
+			if child < 0 || child >= numNodes {
+				return nnfNode{}, fmt.Errorf("child %d does not precede its parent", child)
+			}
+		}
+		return nnfNode{kind: kind, children: children[1:]}, nil
+	default:
+		return nnfNode{}, fmt.Errorf("unknown node type '%s'", kind)
+	}
+}
+
+func buildNNF(fac formula.Factory, nodes []nnfNode, names map[int]string) formula.Formula {
+	formulas := make([]formula.Formula, len(nodes))
+	for i, node := range nodes {
+		switch node.kind {
+		case "L":
+			index := max(node.literal, -node.literal)
+			name, ok := names[index]
+			if !ok {
+				name = fmt.Sprintf("v%d", index)
+			}
+			formulas[i] = fac.Literal(name, node.literal > 0)
+		default:
+			ops := make([]formula.Formula, len(node.children))
+			for j, child := range node.children {
+				ops[j] = formulas[child]
+			}
+			if node.kind == "A" {
+				formulas[i] = fac.And(ops...)
+			} else {
+				formulas[i] = fac.Or(ops...)
+			}
+		}
+	}
+	return formulas[len(formulas)-1]
+}
@@ -0,0 +1,177 @@
+package sio
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type opbInput[T any] interface {
+	DeserOPB([]byte) (T, error)
+}
+
+type opbTerm struct {
+	coefficient int
+	literal     string
+}
+
+type opbInstance struct {
+	objective   []opbTerm
+	constraints []string
+}
+
+var opbVarName = regexp.MustCompile(`^[A-Za-z_#][A-Za-z0-9_#]*$`)
+
+var opbComparators = map[string]func(int, int) bool{
+	"=":  func(l, r int) bool { return l == r },
+	">=": func(l, r int) bool { return l >= r },
+	">":  func(l, r int) bool { return l > r },
+	"<=": func(l, r int) bool { return l <= r },
+	"<":  func(l, r int) bool { return l < r },
+}
+
+func (FormulaInput) DeserOPB(data []byte) (FormulaInput, error) {
+	instance, err := parseOPB(data)
+	if err != nil {
+		return FormulaInput{}, err
+	}
+	if instance.objective != nil {
+		return FormulaInput{}, fmt.Errorf("OPB objectives are only supported by the MaxSAT solver")
+	}
+	formulas := make([]Formula, len(instance.constraints))
+	for i, c := range instance.constraints {
+		formulas[i] = Formula{Formula: c}
+	}
+	return FormulaInput{Formulas: formulas}, nil
+}
+
+func (MaxSatInput) DeserOPB(data []byte) (MaxSatInput, error) {
+	instance, err := parseOPB(data)
+	if err != nil {
+		return MaxSatInput{}, err
+	}
+	hardFormulas := make([]Formula, len(instance.constraints))
+	for i, c := range instance.constraints {
+		hardFormulas[i] = Formula{Formula: c}
+	}
+	softFormulas := make(map[string]int64)
+	var offset int64
+	for _, t := range instance.objective {
+		switch {
+		case t.coefficient > 0:
+			softFormulas[negateOPBLiteral(t.literal)] += int64(t.coefficient)
+		case t.coefficient < 0:
+			softFormulas[t.literal] += int64(-t.coefficient)
+			offset += int64(t.coefficient)
+		}
+	}
+	return MaxSatInput{hardFormulas, softFormulas, offset}, nil
+}
+
+func parseOPB(data []byte) (*opbInstance, error) {
+	var content strings.Builder
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "*") {
+			content.WriteString(line)
+			content.WriteString(" ")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	instance := &opbInstance{}
+	statements := strings.Split(content.String(), ";")
+	if rest := strings.TrimSpace(statements[len(statements)-1]); rest != "" {
+		return nil, fmt.Errorf("OPB statement '%s' is not terminated by ';'", rest)
+	}
+	for _, statement := range statements[:len(statements)-1] {
+		tokens := strings.Fields(statement)
+		if len(tokens) == 0 {
+			continue
+		}
+		if tokens[0] == "min:" {
+			if instance.objective != nil {
+				return nil, fmt.Errorf("OPB input contains more than one objective")
+			}
+			terms, err := parseOPBTerms(tokens[1:])
+			if err != nil {
+				return nil, err
+			}
+			instance.objective = terms
+			continue
+		}
+		constraint, err := parseOPBConstraint(tokens)
+		if err != nil {
+			return nil, err
+		}
+		instance.constraints = append(instance.constraints, constraint)
+	}
+	return instance, nil
+}
+
+func parseOPBConstraint(tokens []string) (string, error) {
+	if len(tokens) < 2 {
+		return "", fmt.Errorf("illegal OPB constraint '%s'", strings.Join(tokens, " "))
+	}
+	comparator := tokens[len(tokens)-2]
+	compare, ok := opbComparators[comparator]
+	if !ok {
+		return "", fmt.Errorf("illegal OPB comparator '%s'", comparator)
+	}
+	rhs, err := strconv.Atoi(tokens[len(tokens)-1])
+	if err != nil {
+		return "", fmt.Errorf("illegal OPB right-hand side '%s'", tokens[len(tokens)-1])
+	}
+	terms, err := parseOPBTerms(tokens[:len(tokens)-2])
+	if err != nil {
+		return "", err
+	}
+	if len(terms) == 0 {
+		if compare(0, rhs) {
+			return "$true", nil
+		}
+		return "$false", nil
+	}
+	summands := make([]string, len(terms))
+	for i, t := range terms {
+		summands[i] = fmt.Sprintf("%d*%s", t.coefficient, t.literal)
+	}
+	return fmt.Sprintf("%s %s %d", strings.Join(summands, " + "), comparator, rhs), nil
+}
+
+func parseOPBTerms(tokens []string) ([]opbTerm, error) {
+	terms := make([]opbTerm, 0, len(tokens)/2)
+	for i := 0; i < len(tokens); i += 2 {
+		coefficient, err := strconv.Atoi(tokens[i])
+		if err != nil {
+			return nil, fmt.Errorf("illegal OPB coefficient '%s'", tokens[i])
+		}
+		if i+1 == len(tokens) {
+			return nil, fmt.Errorf("OPB coefficient '%s' without literal", tokens[i])
+		}
+		literal := tokens[i+1]
+		if !opbVarName.MatchString(strings.TrimPrefix(literal, "~")) {
+			return nil, fmt.Errorf("illegal OPB literal '%s'", literal)
+		}
+		if i+2 < len(tokens) {
+			if _, err := strconv.Atoi(tokens[i+2]); err != nil {
+				return nil, fmt.Errorf("non-linear OPB terms are not supported")
+			}
+		}
+		terms = append(terms, opbTerm{coefficient, literal})
+	}
+	return terms, nil
+}
+
+func negateOPBLiteral(literal string) string {
+	if strings.HasPrefix(literal, "~") {
+		return literal[1:]
+	}
+	return "~" + literal
+}
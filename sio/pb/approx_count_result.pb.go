@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: approx_count_result.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApproxCountResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State             *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Value             string            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	LowerBound        string            `protobuf:"bytes,3,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound        string            `protobuf:"bytes,4,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	Confidence        float64           `protobuf:"fixed64,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Exact             bool              `protobuf:"varint,6,opt,name=exact,proto3" json:"exact,omitempty"`
+	FailedAssumptions []string          `protobuf:"bytes,7,rep,name=failedAssumptions,proto3" json:"failedAssumptions,omitempty"`
+}
+
+func (x *ApproxCountResult) Reset() {
+	*x = ApproxCountResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_approx_count_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproxCountResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproxCountResult) ProtoMessage() {}
+
+func (x *ApproxCountResult) ProtoReflect() protoreflect.Message {
+	mi := &file_approx_count_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproxCountResult.ProtoReflect.Descriptor instead.
+func (*ApproxCountResult) Descriptor() ([]byte, []int) {
+	return file_approx_count_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApproxCountResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ApproxCountResult) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ApproxCountResult) GetLowerBound() string {
+	if x != nil {
+		return x.LowerBound
+	}
+	return ""
+}
+
+func (x *ApproxCountResult) GetUpperBound() string {
+	if x != nil {
+		return x.UpperBound
+	}
+	return ""
+}
+
+func (x *ApproxCountResult) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *ApproxCountResult) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *ApproxCountResult) GetFailedAssumptions() []string {
+	if x != nil {
+		return x.FailedAssumptions
+	}
+	return nil
+}
+
+var File_approx_count_result_proto protoreflect.FileDescriptor
+
+var file_approx_count_result_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x78, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x0d,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x02,
+	0x0a, 0x11, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75,
+	0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x75, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61,
+	0x63, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73, 0x75,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e,
+	0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_approx_count_result_proto_rawDescOnce sync.Once
+	file_approx_count_result_proto_rawDescData = file_approx_count_result_proto_rawDesc
+)
+
+func file_approx_count_result_proto_rawDescGZIP() []byte {
+	file_approx_count_result_proto_rawDescOnce.Do(func() {
+		file_approx_count_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_approx_count_result_proto_rawDescData)
+	})
+	return file_approx_count_result_proto_rawDescData
+}
+
+var file_approx_count_result_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_approx_count_result_proto_goTypes = []interface{}{
+	(*ApproxCountResult)(nil), // 0: approxcountresult.ApproxCountResult
+	(*ComputationState)(nil),  // 1: generic.ComputationState
+}
+var file_approx_count_result_proto_depIdxs = []int32{
+	1, // 0: approxcountresult.ApproxCountResult.state:type_name -> generic.ComputationState
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_approx_count_result_proto_init() }
+func file_approx_count_result_proto_init() {
+	if File_approx_count_result_proto != nil {
+		return
+	}
+	file_generic_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_approx_count_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproxCountResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_approx_count_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_approx_count_result_proto_goTypes,
+		DependencyIndexes: file_approx_count_result_proto_depIdxs,
+		MessageInfos:      file_approx_count_result_proto_msgTypes,
+	}.Build()
+	File_approx_count_result_proto = out.File
+	file_approx_count_result_proto_rawDesc = nil
+	file_approx_count_result_proto_goTypes = nil
+	file_approx_count_result_proto_depIdxs = nil
+}
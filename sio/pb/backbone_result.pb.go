@@ -25,11 +25,12 @@ type BackboneResult struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State       *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Satisfiable bool              `protobuf:"varint,2,opt,name=satisfiable,proto3" json:"satisfiable,omitempty"`
-	Positive    []string          `protobuf:"bytes,3,rep,name=positive,proto3" json:"positive,omitempty"`
-	Negative    []string          `protobuf:"bytes,4,rep,name=negative,proto3" json:"negative,omitempty"`
-	Optional    []string          `protobuf:"bytes,5,rep,name=optional,proto3" json:"optional,omitempty"`
+	State             *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Satisfiable       bool              `protobuf:"varint,2,opt,name=satisfiable,proto3" json:"satisfiable,omitempty"`
+	Positive          []string          `protobuf:"bytes,3,rep,name=positive,proto3" json:"positive,omitempty"`
+	Negative          []string          `protobuf:"bytes,4,rep,name=negative,proto3" json:"negative,omitempty"`
+	Optional          []string          `protobuf:"bytes,5,rep,name=optional,proto3" json:"optional,omitempty"`
+	FailedAssumptions []string          `protobuf:"bytes,6,rep,name=failedAssumptions,proto3" json:"failedAssumptions,omitempty"`
 }
 
 func (x *BackboneResult) Reset() {
@@ -99,13 +100,20 @@ func (x *BackboneResult) GetOptional() []string {
 	return nil
 }
 
+func (x *BackboneResult) GetFailedAssumptions() []string {
+	if x != nil {
+		return x.FailedAssumptions
+	}
+	return nil
+}
+
 var File_backbone_result_proto protoreflect.FileDescriptor
 
 var file_backbone_result_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x62, 0x61, 0x63, 0x6b, 0x62, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x62, 0x62, 0x72, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x1a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xb7, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x62, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73,
+	0x22, 0xe5, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x62, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
 	0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
@@ -116,11 +124,13 @@ var file_backbone_result_proto_rawDesc = []byte{
 	0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a,
 	0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f,
-	0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73,
+	0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
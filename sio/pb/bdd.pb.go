@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: bdd.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BDD struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order []string   `protobuf:"bytes,1,rep,name=order,proto3" json:"order,omitempty"`
+	Nodes []*BDDNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Root  int32      `protobuf:"varint,3,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *BDD) Reset() {
+	*x = BDD{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bdd_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BDD) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BDD) ProtoMessage() {}
+
+func (x *BDD) ProtoReflect() protoreflect.Message {
+	mi := &file_bdd_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BDD.ProtoReflect.Descriptor instead.
+func (*BDD) Descriptor() ([]byte, []int) {
+	return file_bdd_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BDD) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *BDD) GetNodes() []*BDDNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *BDD) GetRoot() int32 {
+	if x != nil {
+		return x.Root
+	}
+	return 0
+}
+
+type BDDNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Variable string `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	Low      int32  `protobuf:"varint,3,opt,name=low,proto3" json:"low,omitempty"`
+	High     int32  `protobuf:"varint,4,opt,name=high,proto3" json:"high,omitempty"`
+}
+
+func (x *BDDNode) Reset() {
+	*x = BDDNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bdd_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BDDNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BDDNode) ProtoMessage() {}
+
+func (x *BDDNode) ProtoReflect() protoreflect.Message {
+	mi := &file_bdd_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BDDNode.ProtoReflect.Descriptor instead.
+func (*BDDNode) Descriptor() ([]byte, []int) {
+	return file_bdd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BDDNode) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BDDNode) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *BDDNode) GetLow() int32 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *BDDNode) GetHigh() int32 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+var File_bdd_proto protoreflect.FileDescriptor
+
+var file_bdd_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x62, 0x64, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x62, 0x64, 0x64,
+	0x22, 0x53, 0x0a, 0x03, 0x42, 0x44, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62,
+	0x64, 0x64, 0x2e, 0x42, 0x44, 0x44, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x5b, 0x0a, 0x07, 0x42, 0x44, 0x44, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x69,
+	0x67, 0x68, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bdd_proto_rawDescOnce sync.Once
+	file_bdd_proto_rawDescData = file_bdd_proto_rawDesc
+)
+
+func file_bdd_proto_rawDescGZIP() []byte {
+	file_bdd_proto_rawDescOnce.Do(func() {
+		file_bdd_proto_rawDescData = protoimpl.X.CompressGZIP(file_bdd_proto_rawDescData)
+	})
+	return file_bdd_proto_rawDescData
+}
+
+var file_bdd_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_bdd_proto_goTypes = []interface{}{
+	(*BDD)(nil),     // 0: bdd.BDD
+	(*BDDNode)(nil), // 1: bdd.BDDNode
+}
+var file_bdd_proto_depIdxs = []int32{
+	1, // 0: bdd.BDD.nodes:type_name -> bdd.BDDNode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_bdd_proto_init() }
+func file_bdd_proto_init() {
+	if File_bdd_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_bdd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BDD); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bdd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BDDNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bdd_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bdd_proto_goTypes,
+		DependencyIndexes: file_bdd_proto_depIdxs,
+		MessageInfos:      file_bdd_proto_msgTypes,
+	}.Build()
+	File_bdd_proto = out.File
+	file_bdd_proto_rawDesc = nil
+	file_bdd_proto_goTypes = nil
+	file_bdd_proto_depIdxs = nil
+}
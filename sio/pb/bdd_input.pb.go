@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: bdd_input.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BDDInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Formulas   []*Formula      `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
+	Bdd        *BDD            `protobuf:"bytes,2,opt,name=bdd,proto3" json:"bdd,omitempty"`
+	Operand    *BDDOperand     `protobuf:"bytes,3,opt,name=operand,proto3" json:"operand,omitempty"`
+	Assignment map[string]bool `protobuf:"bytes,4,rep,name=assignment,proto3" json:"assignment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Variables  []string        `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty"`
+}
+
+func (x *BDDInput) Reset() {
+	*x = BDDInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bdd_input_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BDDInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BDDInput) ProtoMessage() {}
+
+func (x *BDDInput) ProtoReflect() protoreflect.Message {
+	mi := &file_bdd_input_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BDDInput.ProtoReflect.Descriptor instead.
+func (*BDDInput) Descriptor() ([]byte, []int) {
+	return file_bdd_input_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BDDInput) GetFormulas() []*Formula {
+	if x != nil {
+		return x.Formulas
+	}
+	return nil
+}
+
+func (x *BDDInput) GetBdd() *BDD {
+	if x != nil {
+		return x.Bdd
+	}
+	return nil
+}
+
+func (x *BDDInput) GetOperand() *BDDOperand {
+	if x != nil {
+		return x.Operand
+	}
+	return nil
+}
+
+func (x *BDDInput) GetAssignment() map[string]bool {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *BDDInput) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type BDDOperand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Formulas []*Formula `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
+	Bdd      *BDD       `protobuf:"bytes,2,opt,name=bdd,proto3" json:"bdd,omitempty"`
+}
+
+func (x *BDDOperand) Reset() {
+	*x = BDDOperand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bdd_input_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BDDOperand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BDDOperand) ProtoMessage() {}
+
+func (x *BDDOperand) ProtoReflect() protoreflect.Message {
+	mi := &file_bdd_input_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BDDOperand.ProtoReflect.Descriptor instead.
+func (*BDDOperand) Descriptor() ([]byte, []int) {
+	return file_bdd_input_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BDDOperand) GetFormulas() []*Formula {
+	if x != nil {
+		return x.Formulas
+	}
+	return nil
+}
+
+func (x *BDDOperand) GetBdd() *BDD {
+	if x != nil {
+		return x.Bdd
+	}
+	return nil
+}
+
+type BDDCompilationInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Formulas []*Formula `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
+	Order    []string   `protobuf:"bytes,2,rep,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *BDDCompilationInput) Reset() {
+	*x = BDDCompilationInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bdd_input_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BDDCompilationInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BDDCompilationInput) ProtoMessage() {}
+
+func (x *BDDCompilationInput) ProtoReflect() protoreflect.Message {
+	mi := &file_bdd_input_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BDDCompilationInput.ProtoReflect.Descriptor instead.
+func (*BDDCompilationInput) Descriptor() ([]byte, []int) {
+	return file_bdd_input_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BDDCompilationInput) GetFormulas() []*Formula {
+	if x != nil {
+		return x.Formulas
+	}
+	return nil
+}
+
+func (x *BDDCompilationInput) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+var File_bdd_input_proto protoreflect.FileDescriptor
+
+var file_bdd_input_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x62, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x08, 0x62, 0x64, 0x64, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0d, 0x66, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x09, 0x62, 0x64, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x02, 0x0a, 0x08, 0x42, 0x44, 0x44, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73,
+	0x12, 0x1a, 0x0a, 0x03, 0x62, 0x64, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x62, 0x64, 0x64, 0x2e, 0x42, 0x44, 0x44, 0x52, 0x03, 0x62, 0x64, 0x64, 0x12, 0x2e, 0x0a, 0x07,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x62, 0x64, 0x64, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x42, 0x44, 0x44, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x6e, 0x64, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x42, 0x0a, 0x0a,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x62, 0x64, 0x64, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x42, 0x44, 0x44, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x56, 0x0a,
+	0x0a, 0x42, 0x44, 0x44, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x66,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52,
+	0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x62, 0x64, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x62, 0x64, 0x64, 0x2e, 0x42, 0x44, 0x44,
+	0x52, 0x03, 0x62, 0x64, 0x64, 0x22, 0x59, 0x0a, 0x13, 0x42, 0x44, 0x44, 0x43, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x08,
+	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x52, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e,
+	0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bdd_input_proto_rawDescOnce sync.Once
+	file_bdd_input_proto_rawDescData = file_bdd_input_proto_rawDesc
+)
+
+func file_bdd_input_proto_rawDescGZIP() []byte {
+	file_bdd_input_proto_rawDescOnce.Do(func() {
+		file_bdd_input_proto_rawDescData = protoimpl.X.CompressGZIP(file_bdd_input_proto_rawDescData)
+	})
+	return file_bdd_input_proto_rawDescData
+}
+
+var file_bdd_input_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_bdd_input_proto_goTypes = []interface{}{
+	(*BDDInput)(nil),            // 0: bddinput.BDDInput
+	(*BDDOperand)(nil),          // 1: bddinput.BDDOperand
+	(*BDDCompilationInput)(nil), // 2: bddinput.BDDCompilationInput
+	nil,                         // 3: bddinput.BDDInput.AssignmentEntry
+	(*Formula)(nil),             // 4: formula.Formula
+	(*BDD)(nil),                 // 5: bdd.BDD
+}
+var file_bdd_input_proto_depIdxs = []int32{
+	4, // 0: bddinput.BDDInput.formulas:type_name -> formula.Formula
+	5, // 1: bddinput.BDDInput.bdd:type_name -> bdd.BDD
+	1, // 2: bddinput.BDDInput.operand:type_name -> bddinput.BDDOperand
+	3, // 3: bddinput.BDDInput.assignment:type_name -> bddinput.BDDInput.AssignmentEntry
+	4, // 4: bddinput.BDDOperand.formulas:type_name -> formula.Formula
+	5, // 5: bddinput.BDDOperand.bdd:type_name -> bdd.BDD
+	4, // 6: bddinput.BDDCompilationInput.formulas:type_name -> formula.Formula
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_bdd_input_proto_init() }
+func file_bdd_input_proto_init() {
+	if File_bdd_input_proto != nil {
+		return
+	}
+	file_formula_proto_init()
+	file_bdd_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_bdd_input_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BDDInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bdd_input_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BDDOperand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bdd_input_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BDDCompilationInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bdd_input_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bdd_input_proto_goTypes,
+		DependencyIndexes: file_bdd_input_proto_depIdxs,
+		MessageInfos:      file_bdd_input_proto_msgTypes,
+	}.Build()
+	File_bdd_input_proto = out.File
+	file_bdd_input_proto_rawDesc = nil
+	file_bdd_input_proto_goTypes = nil
+	file_bdd_input_proto_depIdxs = nil
+}
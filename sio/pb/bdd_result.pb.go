@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: bdd_result.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BDDResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Bdd   *BDD              `protobuf:"bytes,2,opt,name=bdd,proto3" json:"bdd,omitempty"`
+}
+
+func (x *BDDResult) Reset() {
+	*x = BDDResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bdd_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BDDResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BDDResult) ProtoMessage() {}
+
+func (x *BDDResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bdd_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BDDResult.ProtoReflect.Descriptor instead.
+func (*BDDResult) Descriptor() ([]byte, []int) {
+	return file_bdd_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BDDResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *BDDResult) GetBdd() *BDD {
+	if x != nil {
+		return x.Bdd
+	}
+	return nil
+}
+
+type BDDOrdering struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ordering  string   `protobuf:"bytes,1,opt,name=ordering,proto3" json:"ordering,omitempty"`
+	Order     []string `protobuf:"bytes,2,rep,name=order,proto3" json:"order,omitempty"`
+	NodeCount int64    `protobuf:"varint,3,opt,name=nodeCount,proto3" json:"nodeCount,omitempty"`
+}
+
+func (x *BDDOrdering) Reset() {
+	*x = BDDOrdering{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bdd_result_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BDDOrdering) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BDDOrdering) ProtoMessage() {}
+
+func (x *BDDOrdering) ProtoReflect() protoreflect.Message {
+	mi := &file_bdd_result_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BDDOrdering.ProtoReflect.Descriptor instead.
+func (*BDDOrdering) Descriptor() ([]byte, []int) {
+	return file_bdd_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BDDOrdering) GetOrdering() string {
+	if x != nil {
+		return x.Ordering
+	}
+	return ""
+}
+
+func (x *BDDOrdering) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *BDDOrdering) GetNodeCount() int64 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+type BDDOrderingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State     *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Orderings []*BDDOrdering    `protobuf:"bytes,2,rep,name=orderings,proto3" json:"orderings,omitempty"`
+}
+
+func (x *BDDOrderingResult) Reset() {
+	*x = BDDOrderingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bdd_result_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BDDOrderingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BDDOrderingResult) ProtoMessage() {}
+
+func (x *BDDOrderingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bdd_result_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BDDOrderingResult.ProtoReflect.Descriptor instead.
+func (*BDDOrderingResult) Descriptor() ([]byte, []int) {
+	return file_bdd_result_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BDDOrderingResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *BDDOrderingResult) GetOrderings() []*BDDOrdering {
+	if x != nil {
+		return x.Orderings
+	}
+	return nil
+}
+
+var File_bdd_result_proto protoreflect.FileDescriptor
+
+var file_bdd_result_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x62, 0x64, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x09, 0x62, 0x64, 0x64, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x0d, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x09, 0x62, 0x64,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x09, 0x42, 0x44, 0x44, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x62, 0x64, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x62, 0x64, 0x64, 0x2e, 0x42, 0x44, 0x44, 0x52, 0x03, 0x62, 0x64,
+	0x64, 0x22, 0x5d, 0x0a, 0x0b, 0x42, 0x44, 0x44, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x7a, 0x0a, 0x11, 0x42, 0x44, 0x44, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x42, 0x44, 0x44, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x2e, 0x5a, 0x2c,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bdd_result_proto_rawDescOnce sync.Once
+	file_bdd_result_proto_rawDescData = file_bdd_result_proto_rawDesc
+)
+
+func file_bdd_result_proto_rawDescGZIP() []byte {
+	file_bdd_result_proto_rawDescOnce.Do(func() {
+		file_bdd_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_bdd_result_proto_rawDescData)
+	})
+	return file_bdd_result_proto_rawDescData
+}
+
+var file_bdd_result_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_bdd_result_proto_goTypes = []interface{}{
+	(*BDDResult)(nil),         // 0: bddresult.BDDResult
+	(*BDDOrdering)(nil),       // 1: bddresult.BDDOrdering
+	(*BDDOrderingResult)(nil), // 2: bddresult.BDDOrderingResult
+	(*ComputationState)(nil),  // 3: generic.ComputationState
+	(*BDD)(nil),               // 4: bdd.BDD
+}
+var file_bdd_result_proto_depIdxs = []int32{
+	3, // 0: bddresult.BDDResult.state:type_name -> generic.ComputationState
+	4, // 1: bddresult.BDDResult.bdd:type_name -> bdd.BDD
+	3, // 2: bddresult.BDDOrderingResult.state:type_name -> generic.ComputationState
+	1, // 3: bddresult.BDDOrderingResult.orderings:type_name -> bddresult.BDDOrdering
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_bdd_result_proto_init() }
+func file_bdd_result_proto_init() {
+	if File_bdd_result_proto != nil {
+		return
+	}
+	file_generic_proto_init()
+	file_bdd_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_bdd_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BDDResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bdd_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BDDOrdering); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bdd_result_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BDDOrderingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bdd_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bdd_result_proto_goTypes,
+		DependencyIndexes: file_bdd_result_proto_depIdxs,
+		MessageInfos:      file_bdd_result_proto_msgTypes,
+	}.Build()
+	File_bdd_result_proto = out.File
+	file_bdd_result_proto_rawDesc = nil
+	file_bdd_result_proto_goTypes = nil
+	file_bdd_result_proto_depIdxs = nil
+}
@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: clause_result.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ClauseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State     *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Variables []string          `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Formulas  []*ClauseSet      `protobuf:"bytes,3,rep,name=formulas,proto3" json:"formulas,omitempty"`
+}
+
+func (x *ClauseResult) Reset() {
+	*x = ClauseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clause_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClauseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClauseResult) ProtoMessage() {}
+
+func (x *ClauseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_clause_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClauseResult.ProtoReflect.Descriptor instead.
+func (*ClauseResult) Descriptor() ([]byte, []int) {
+	return file_clause_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClauseResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ClauseResult) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *ClauseResult) GetFormulas() []*ClauseSet {
+	if x != nil {
+		return x.Formulas
+	}
+	return nil
+}
+
+type ClauseSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string       `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Clauses     []*Clause    `protobuf:"bytes,2,rep,name=clauses,proto3" json:"clauses,omitempty"`
+	IntClauses  []*IntClause `protobuf:"bytes,3,rep,name=intClauses,proto3" json:"intClauses,omitempty"`
+}
+
+func (x *ClauseSet) Reset() {
+	*x = ClauseSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clause_result_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClauseSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClauseSet) ProtoMessage() {}
+
+func (x *ClauseSet) ProtoReflect() protoreflect.Message {
+	mi := &file_clause_result_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClauseSet.ProtoReflect.Descriptor instead.
+func (*ClauseSet) Descriptor() ([]byte, []int) {
+	return file_clause_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClauseSet) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ClauseSet) GetClauses() []*Clause {
+	if x != nil {
+		return x.Clauses
+	}
+	return nil
+}
+
+func (x *ClauseSet) GetIntClauses() []*IntClause {
+	if x != nil {
+		return x.IntClauses
+	}
+	return nil
+}
+
+type Clause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Literals []string `protobuf:"bytes,1,rep,name=literals,proto3" json:"literals,omitempty"`
+}
+
+func (x *Clause) Reset() {
+	*x = Clause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clause_result_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clause) ProtoMessage() {}
+
+func (x *Clause) ProtoReflect() protoreflect.Message {
+	mi := &file_clause_result_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clause.ProtoReflect.Descriptor instead.
+func (*Clause) Descriptor() ([]byte, []int) {
+	return file_clause_result_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Clause) GetLiterals() []string {
+	if x != nil {
+		return x.Literals
+	}
+	return nil
+}
+
+type IntClause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Literals []int32 `protobuf:"varint,1,rep,packed,name=literals,proto3" json:"literals,omitempty"`
+}
+
+func (x *IntClause) Reset() {
+	*x = IntClause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clause_result_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntClause) ProtoMessage() {}
+
+func (x *IntClause) ProtoReflect() protoreflect.Message {
+	mi := &file_clause_result_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntClause.ProtoReflect.Descriptor instead.
+func (*IntClause) Descriptor() ([]byte, []int) {
+	return file_clause_result_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IntClause) GetLiterals() []int32 {
+	if x != nil {
+		return x.Literals
+	}
+	return nil
+}
+
+var File_clause_result_proto protoreflect.FileDescriptor
+
+var file_clause_result_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x1a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x74, 0x52, 0x08, 0x66,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x09, 0x43, 0x6c, 0x61, 0x75,
+	0x73, 0x65, 0x53, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x61, 0x75, 0x73,
+	0x65, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x07,
+	0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x43, 0x6c,
+	0x61, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c,
+	0x61, 0x75, 0x73, 0x65, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x49, 0x6e, 0x74, 0x43, 0x6c,
+	0x61, 0x75, 0x73, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x73,
+	0x22, 0x24, 0x0a, 0x06, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x22, 0x27, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x43, 0x6c, 0x61,
+	0x75, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x42,
+	0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f,
+	0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_clause_result_proto_rawDescOnce sync.Once
+	file_clause_result_proto_rawDescData = file_clause_result_proto_rawDesc
+)
+
+func file_clause_result_proto_rawDescGZIP() []byte {
+	file_clause_result_proto_rawDescOnce.Do(func() {
+		file_clause_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_clause_result_proto_rawDescData)
+	})
+	return file_clause_result_proto_rawDescData
+}
+
+var file_clause_result_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_clause_result_proto_goTypes = []interface{}{
+	(*ClauseResult)(nil),     // 0: clauseresult.ClauseResult
+	(*ClauseSet)(nil),        // 1: clauseresult.ClauseSet
+	(*Clause)(nil),           // 2: clauseresult.Clause
+	(*IntClause)(nil),        // 3: clauseresult.IntClause
+	(*ComputationState)(nil), // 4: generic.ComputationState
+}
+var file_clause_result_proto_depIdxs = []int32{
+	4, // 0: clauseresult.ClauseResult.state:type_name -> generic.ComputationState
+	1, // 1: clauseresult.ClauseResult.formulas:type_name -> clauseresult.ClauseSet
+	2, // 2: clauseresult.ClauseSet.clauses:type_name -> clauseresult.Clause
+	3, // 3: clauseresult.ClauseSet.intClauses:type_name -> clauseresult.IntClause
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_clause_result_proto_init() }
+func file_clause_result_proto_init() {
+	if File_clause_result_proto != nil {
+		return
+	}
+	file_generic_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_clause_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClauseResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clause_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClauseSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clause_result_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clause_result_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntClause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_clause_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_clause_result_proto_goTypes,
+		DependencyIndexes: file_clause_result_proto_depIdxs,
+		MessageInfos:      file_clause_result_proto_msgTypes,
+	}.Build()
+	File_clause_result_proto = out.File
+	file_clause_result_proto_rawDesc = nil
+	file_clause_result_proto_goTypes = nil
+	file_clause_result_proto_depIdxs = nil
+}
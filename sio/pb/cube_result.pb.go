@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: cube_result.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Cube struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mask   []byte `protobuf:"bytes,1,opt,name=mask,proto3" json:"mask,omitempty"`
+	Phases []byte `protobuf:"bytes,2,opt,name=phases,proto3" json:"phases,omitempty"`
+	Count  string `protobuf:"bytes,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Cube) Reset() {
+	*x = Cube{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cube_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cube) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cube) ProtoMessage() {}
+
+func (x *Cube) ProtoReflect() protoreflect.Message {
+	mi := &file_cube_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cube.ProtoReflect.Descriptor instead.
+func (*Cube) Descriptor() ([]byte, []int) {
+	return file_cube_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cube) GetMask() []byte {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+func (x *Cube) GetPhases() []byte {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *Cube) GetCount() string {
+	if x != nil {
+		return x.Count
+	}
+	return ""
+}
+
+type CubeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State             *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Variables         []string          `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Cubes             []*Cube           `protobuf:"bytes,3,rep,name=cubes,proto3" json:"cubes,omitempty"`
+	FailedAssumptions []string          `protobuf:"bytes,4,rep,name=failedAssumptions,proto3" json:"failedAssumptions,omitempty"`
+}
+
+func (x *CubeResult) Reset() {
+	*x = CubeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cube_result_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CubeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CubeResult) ProtoMessage() {}
+
+func (x *CubeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cube_result_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CubeResult.ProtoReflect.Descriptor instead.
+func (*CubeResult) Descriptor() ([]byte, []int) {
+	return file_cube_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CubeResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *CubeResult) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CubeResult) GetCubes() []*Cube {
+	if x != nil {
+		return x.Cubes
+	}
+	return nil
+}
+
+func (x *CubeResult) GetFailedAssumptions() []string {
+	if x != nil {
+		return x.FailedAssumptions
+	}
+	return nil
+}
+
+var File_cube_result_proto protoreflect.FileDescriptor
+
+var file_cube_result_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x63, 0x75, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x63, 0x75, 0x62, 0x65, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a,
+	0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x48,
+	0x0a, 0x04, 0x43, 0x75, 0x62, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x0a, 0x43, 0x75, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x75, 0x62, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x75, 0x62, 0x65, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x43, 0x75, 0x62, 0x65, 0x52, 0x05, 0x63, 0x75, 0x62, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x2e, 0x5a, 0x2c,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cube_result_proto_rawDescOnce sync.Once
+	file_cube_result_proto_rawDescData = file_cube_result_proto_rawDesc
+)
+
+func file_cube_result_proto_rawDescGZIP() []byte {
+	file_cube_result_proto_rawDescOnce.Do(func() {
+		file_cube_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_cube_result_proto_rawDescData)
+	})
+	return file_cube_result_proto_rawDescData
+}
+
+var file_cube_result_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cube_result_proto_goTypes = []interface{}{
+	(*Cube)(nil),             // 0: cuberesult.Cube
+	(*CubeResult)(nil),       // 1: cuberesult.CubeResult
+	(*ComputationState)(nil), // 2: generic.ComputationState
+}
+var file_cube_result_proto_depIdxs = []int32{
+	2, // 0: cuberesult.CubeResult.state:type_name -> generic.ComputationState
+	0, // 1: cuberesult.CubeResult.cubes:type_name -> cuberesult.Cube
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cube_result_proto_init() }
+func file_cube_result_proto_init() {
+	if File_cube_result_proto != nil {
+		return
+	}
+	file_generic_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_cube_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cube); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cube_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CubeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cube_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cube_result_proto_goTypes,
+		DependencyIndexes: file_cube_result_proto_depIdxs,
+		MessageInfos:      file_cube_result_proto_msgTypes,
+	}.Build()
+	File_cube_result_proto = out.File
+	file_cube_result_proto_rawDesc = nil
+	file_cube_result_proto_goTypes = nil
+	file_cube_result_proto_depIdxs = nil
+}
@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: diff_input.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DiffInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Old          []*Formula    `protobuf:"bytes,1,rep,name=old,proto3" json:"old,omitempty"`
+	New          []*Formula    `protobuf:"bytes,2,rep,name=new,proto3" json:"new,omitempty"`
+	SolverConfig *SolverConfig `protobuf:"bytes,3,opt,name=solverConfig,proto3" json:"solverConfig,omitempty"`
+}
+
+func (x *DiffInput) Reset() {
+	*x = DiffInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diff_input_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffInput) ProtoMessage() {}
+
+func (x *DiffInput) ProtoReflect() protoreflect.Message {
+	mi := &file_diff_input_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffInput.ProtoReflect.Descriptor instead.
+func (*DiffInput) Descriptor() ([]byte, []int) {
+	return file_diff_input_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DiffInput) GetOld() []*Formula {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *DiffInput) GetNew() []*Formula {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+func (x *DiffInput) GetSolverConfig() *SolverConfig {
+	if x != nil {
+		return x.SolverConfig
+	}
+	return nil
+}
+
+var File_diff_input_proto protoreflect.FileDescriptor
+
+var file_diff_input_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x09, 0x64, 0x69, 0x66, 0x66, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0d, 0x66,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x22, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x03,
+	0x6f, 0x6c, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_diff_input_proto_rawDescOnce sync.Once
+	file_diff_input_proto_rawDescData = file_diff_input_proto_rawDesc
+)
+
+func file_diff_input_proto_rawDescGZIP() []byte {
+	file_diff_input_proto_rawDescOnce.Do(func() {
+		file_diff_input_proto_rawDescData = protoimpl.X.CompressGZIP(file_diff_input_proto_rawDescData)
+	})
+	return file_diff_input_proto_rawDescData
+}
+
+var file_diff_input_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_diff_input_proto_goTypes = []interface{}{
+	(*DiffInput)(nil),    // 0: diffinput.DiffInput
+	(*Formula)(nil),      // 1: formula.Formula
+	(*SolverConfig)(nil), // 2: solverconfig.SolverConfig
+}
+var file_diff_input_proto_depIdxs = []int32{
+	1, // 0: diffinput.DiffInput.old:type_name -> formula.Formula
+	1, // 1: diffinput.DiffInput.new:type_name -> formula.Formula
+	2, // 2: diffinput.DiffInput.solverConfig:type_name -> solverconfig.SolverConfig
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_diff_input_proto_init() }
+func file_diff_input_proto_init() {
+	if File_diff_input_proto != nil {
+		return
+	}
+	file_formula_proto_init()
+	file_solver_config_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_diff_input_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_diff_input_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_diff_input_proto_goTypes,
+		DependencyIndexes: file_diff_input_proto_depIdxs,
+		MessageInfos:      file_diff_input_proto_msgTypes,
+	}.Build()
+	File_diff_input_proto = out.File
+	file_diff_input_proto_rawDesc = nil
+	file_diff_input_proto_goTypes = nil
+	file_diff_input_proto_depIdxs = nil
+}
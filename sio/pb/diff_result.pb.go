@@ -0,0 +1,413 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: diff_result.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ModelDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  string     `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+	Cubes  []*Cube    `protobuf:"bytes,2,rep,name=cubes,proto3" json:"cubes,omitempty"`
+	Causes []*Formula `protobuf:"bytes,3,rep,name=causes,proto3" json:"causes,omitempty"`
+}
+
+func (x *ModelDiff) Reset() {
+	*x = ModelDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diff_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelDiff) ProtoMessage() {}
+
+func (x *ModelDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_diff_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelDiff.ProtoReflect.Descriptor instead.
+func (*ModelDiff) Descriptor() ([]byte, []int) {
+	return file_diff_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ModelDiff) GetCount() string {
+	if x != nil {
+		return x.Count
+	}
+	return ""
+}
+
+func (x *ModelDiff) GetCubes() []*Cube {
+	if x != nil {
+		return x.Cubes
+	}
+	return nil
+}
+
+func (x *ModelDiff) GetCauses() []*Formula {
+	if x != nil {
+		return x.Causes
+	}
+	return nil
+}
+
+type BackboneChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Literal string     `protobuf:"bytes,1,opt,name=literal,proto3" json:"literal,omitempty"`
+	Change  string     `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	Causes  []*Formula `protobuf:"bytes,3,rep,name=causes,proto3" json:"causes,omitempty"`
+}
+
+func (x *BackboneChange) Reset() {
+	*x = BackboneChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diff_result_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackboneChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackboneChange) ProtoMessage() {}
+
+func (x *BackboneChange) ProtoReflect() protoreflect.Message {
+	mi := &file_diff_result_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackboneChange.ProtoReflect.Descriptor instead.
+func (*BackboneChange) Descriptor() ([]byte, []int) {
+	return file_diff_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BackboneChange) GetLiteral() string {
+	if x != nil {
+		return x.Literal
+	}
+	return ""
+}
+
+func (x *BackboneChange) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *BackboneChange) GetCauses() []*Formula {
+	if x != nil {
+		return x.Causes
+	}
+	return nil
+}
+
+type DiffResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State     *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Variables []string          `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Added     []*Formula        `protobuf:"bytes,3,rep,name=added,proto3" json:"added,omitempty"`
+	Removed   []*Formula        `protobuf:"bytes,4,rep,name=removed,proto3" json:"removed,omitempty"`
+	Gained    *ModelDiff        `protobuf:"bytes,5,opt,name=gained,proto3" json:"gained,omitempty"`
+	Lost      *ModelDiff        `protobuf:"bytes,6,opt,name=lost,proto3" json:"lost,omitempty"`
+	Backbone  []*BackboneChange `protobuf:"bytes,7,rep,name=backbone,proto3" json:"backbone,omitempty"`
+	Dead      []string          `protobuf:"bytes,8,rep,name=dead,proto3" json:"dead,omitempty"`
+	Forced    []string          `protobuf:"bytes,9,rep,name=forced,proto3" json:"forced,omitempty"`
+}
+
+func (x *DiffResult) Reset() {
+	*x = DiffResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diff_result_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffResult) ProtoMessage() {}
+
+func (x *DiffResult) ProtoReflect() protoreflect.Message {
+	mi := &file_diff_result_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffResult.ProtoReflect.Descriptor instead.
+func (*DiffResult) Descriptor() ([]byte, []int) {
+	return file_diff_result_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DiffResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *DiffResult) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *DiffResult) GetAdded() []*Formula {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *DiffResult) GetRemoved() []*Formula {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *DiffResult) GetGained() *ModelDiff {
+	if x != nil {
+		return x.Gained
+	}
+	return nil
+}
+
+func (x *DiffResult) GetLost() *ModelDiff {
+	if x != nil {
+		return x.Lost
+	}
+	return nil
+}
+
+func (x *DiffResult) GetBackbone() []*BackboneChange {
+	if x != nil {
+		return x.Backbone
+	}
+	return nil
+}
+
+func (x *DiffResult) GetDead() []string {
+	if x != nil {
+		return x.Dead
+	}
+	return nil
+}
+
+func (x *DiffResult) GetForced() []string {
+	if x != nil {
+		return x.Forced
+	}
+	return nil
+}
+
+var File_diff_result_proto protoreflect.FileDescriptor
+
+var file_diff_result_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a,
+	0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d,
+	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x63,
+	0x75, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x73, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x69, 0x66, 0x66, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x75, 0x62, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x75, 0x62, 0x65, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e,
+	0x43, 0x75, 0x62, 0x65, 0x52, 0x05, 0x63, 0x75, 0x62, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x63,
+	0x61, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x06, 0x63,
+	0x61, 0x75, 0x73, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x62, 0x6f, 0x6e,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x61, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x06, 0x63, 0x61, 0x75,
+	0x73, 0x65, 0x73, 0x22, 0xed, 0x02, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x07, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x67, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x69, 0x66, 0x66, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x69, 0x66, 0x66, 0x52, 0x06, 0x67, 0x61,
+	0x69, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x69, 0x66, 0x66, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x62, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x66, 0x66, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x62, 0x6f, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x62,
+	0x61, 0x63, 0x6b, 0x62, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x64, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x64, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x64, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_diff_result_proto_rawDescOnce sync.Once
+	file_diff_result_proto_rawDescData = file_diff_result_proto_rawDesc
+)
+
+func file_diff_result_proto_rawDescGZIP() []byte {
+	file_diff_result_proto_rawDescOnce.Do(func() {
+		file_diff_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_diff_result_proto_rawDescData)
+	})
+	return file_diff_result_proto_rawDescData
+}
+
+var file_diff_result_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_diff_result_proto_goTypes = []interface{}{
+	(*ModelDiff)(nil),        // 0: diffresult.ModelDiff
+	(*BackboneChange)(nil),   // 1: diffresult.BackboneChange
+	(*DiffResult)(nil),       // 2: diffresult.DiffResult
+	(*Cube)(nil),             // 3: cuberesult.Cube
+	(*Formula)(nil),          // 4: formula.Formula
+	(*ComputationState)(nil), // 5: generic.ComputationState
+}
+var file_diff_result_proto_depIdxs = []int32{
+	3, // 0: diffresult.ModelDiff.cubes:type_name -> cuberesult.Cube
+	4, // 1: diffresult.ModelDiff.causes:type_name -> formula.Formula
+	4, // 2: diffresult.BackboneChange.causes:type_name -> formula.Formula
+	5, // 3: diffresult.DiffResult.state:type_name -> generic.ComputationState
+	4, // 4: diffresult.DiffResult.added:type_name -> formula.Formula
+	4, // 5: diffresult.DiffResult.removed:type_name -> formula.Formula
+	0, // 6: diffresult.DiffResult.gained:type_name -> diffresult.ModelDiff
+	0, // 7: diffresult.DiffResult.lost:type_name -> diffresult.ModelDiff
+	1, // 8: diffresult.DiffResult.backbone:type_name -> diffresult.BackboneChange
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_diff_result_proto_init() }
+func file_diff_result_proto_init() {
+	if File_diff_result_proto != nil {
+		return
+	}
+	file_generic_proto_init()
+	file_formula_proto_init()
+	file_cube_result_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_diff_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diff_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackboneChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diff_result_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_diff_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_diff_result_proto_goTypes,
+		DependencyIndexes: file_diff_result_proto_depIdxs,
+		MessageInfos:      file_diff_result_proto_msgTypes,
+	}.Build()
+	File_diff_result_proto = out.File
+	file_diff_result_proto_rawDesc = nil
+	file_diff_result_proto_goTypes = nil
+	file_diff_result_proto_depIdxs = nil
+}
@@ -25,8 +25,9 @@ type Formula struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formula     string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Formula     string      `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Ast         *FormulaAST `protobuf:"bytes,3,opt,name=ast,proto3" json:"ast,omitempty"`
 }
 
 func (x *Formula) Reset() {
@@ -75,19 +76,145 @@ func (x *Formula) GetDescription() string {
 	return ""
 }
 
+func (x *Formula) GetAst() *FormulaAST {
+	if x != nil {
+		return x.Ast
+	}
+	return nil
+}
+
+type FormulaAST struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op           string        `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Name         string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ops          []*FormulaAST `protobuf:"bytes,3,rep,name=ops,proto3" json:"ops,omitempty"`
+	Vars         []string      `protobuf:"bytes,4,rep,name=vars,proto3" json:"vars,omitempty"`
+	Lits         []string      `protobuf:"bytes,5,rep,name=lits,proto3" json:"lits,omitempty"`
+	Coefficients []int64       `protobuf:"varint,6,rep,packed,name=coefficients,proto3" json:"coefficients,omitempty"`
+	Comparator   string        `protobuf:"bytes,7,opt,name=comparator,proto3" json:"comparator,omitempty"`
+	Rhs          int64         `protobuf:"varint,8,opt,name=rhs,proto3" json:"rhs,omitempty"`
+}
+
+func (x *FormulaAST) Reset() {
+	*x = FormulaAST{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_formula_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormulaAST) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormulaAST) ProtoMessage() {}
+
+func (x *FormulaAST) ProtoReflect() protoreflect.Message {
+	mi := &file_formula_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormulaAST.ProtoReflect.Descriptor instead.
+func (*FormulaAST) Descriptor() ([]byte, []int) {
+	return file_formula_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FormulaAST) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *FormulaAST) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FormulaAST) GetOps() []*FormulaAST {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *FormulaAST) GetVars() []string {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *FormulaAST) GetLits() []string {
+	if x != nil {
+		return x.Lits
+	}
+	return nil
+}
+
+func (x *FormulaAST) GetCoefficients() []int64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *FormulaAST) GetComparator() string {
+	if x != nil {
+		return x.Comparator
+	}
+	return ""
+}
+
+func (x *FormulaAST) GetRhs() int64 {
+	if x != nil {
+		return x.Rhs
+	}
+	return 0
+}
+
 var File_formula_proto protoreflect.FileDescriptor
 
 var file_formula_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x22, 0x45, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x6d,
+	0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x22, 0x6c, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x6d,
 	0x75, 0x6c, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x20, 0x0a,
 	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f,
-	0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67,
-	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x0a, 0x03, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x41, 0x53,
+	0x54, 0x52, 0x03, 0x61, 0x73, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x41, 0x53, 0x54, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x6f, 0x70, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x41, 0x53, 0x54, 0x52, 0x03, 0x6f, 0x70, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x76, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x65, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c,
+	0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x68, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x68, 0x73, 0x42, 0x2e,
+	0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f,
+	0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -102,16 +229,19 @@ func file_formula_proto_rawDescGZIP() []byte {
 	return file_formula_proto_rawDescData
 }
 
-var file_formula_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_formula_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_formula_proto_goTypes = []interface{}{
-	(*Formula)(nil), // 0: formula.Formula
+	(*Formula)(nil),    // 0: formula.Formula
+	(*FormulaAST)(nil), // 1: formula.FormulaAST
 }
 var file_formula_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: formula.Formula.ast:type_name -> formula.FormulaAST
+	1, // 1: formula.FormulaAST.ops:type_name -> formula.FormulaAST
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_formula_proto_init() }
@@ -132,6 +262,18 @@ func file_formula_proto_init() {
 				return nil
 			}
 		}
+		file_formula_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormulaAST); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -139,7 +281,7 @@ func file_formula_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_formula_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
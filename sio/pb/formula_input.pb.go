@@ -25,7 +25,9 @@ type FormulaInput struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formulas []*Formula `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
+	Formulas     []*Formula    `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
+	Assumptions  []string      `protobuf:"bytes,2,rep,name=assumptions,proto3" json:"assumptions,omitempty"`
+	SolverConfig *SolverConfig `protobuf:"bytes,3,opt,name=solverConfig,proto3" json:"solverConfig,omitempty"`
 }
 
 func (x *FormulaInput) Reset() {
@@ -67,20 +69,41 @@ func (x *FormulaInput) GetFormulas() []*Formula {
 	return nil
 }
 
+func (x *FormulaInput) GetAssumptions() []string {
+	if x != nil {
+		return x.Assumptions
+	}
+	return nil
+}
+
+func (x *FormulaInput) GetSolverConfig() *SolverConfig {
+	if x != nil {
+		return x.SolverConfig
+	}
+	return nil
+}
+
 var File_formula_input_proto protoreflect.FileDescriptor
 
 var file_formula_input_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x69, 0x6e,
 	0x70, 0x75, 0x74, 0x1a, 0x0d, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x3c, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46,
-	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73,
-	0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
-	0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e,
-	0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x1a, 0x13, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x08, 0x66, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73,
+	0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -99,14 +122,16 @@ var file_formula_input_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_formula_input_proto_goTypes = []interface{}{
 	(*FormulaInput)(nil), // 0: formulainput.FormulaInput
 	(*Formula)(nil),      // 1: formula.Formula
+	(*SolverConfig)(nil), // 2: solverconfig.SolverConfig
 }
 var file_formula_input_proto_depIdxs = []int32{
 	1, // 0: formulainput.FormulaInput.formulas:type_name -> formula.Formula
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: formulainput.FormulaInput.solverConfig:type_name -> solverconfig.SolverConfig
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_formula_input_proto_init() }
@@ -115,6 +140,7 @@ func file_formula_input_proto_init() {
 		return
 	}
 	file_formula_proto_init()
+	file_solver_config_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_formula_input_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FormulaInput); i {
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: marginals_input.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MarginalsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Formulas     []*Formula    `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
+	Variables    []string      `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Projection   []string      `protobuf:"bytes,3,rep,name=projection,proto3" json:"projection,omitempty"`
+	SolverConfig *SolverConfig `protobuf:"bytes,4,opt,name=solverConfig,proto3" json:"solverConfig,omitempty"`
+}
+
+func (x *MarginalsInput) Reset() {
+	*x = MarginalsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marginals_input_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarginalsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginalsInput) ProtoMessage() {}
+
+func (x *MarginalsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_marginals_input_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginalsInput.ProtoReflect.Descriptor instead.
+func (*MarginalsInput) Descriptor() ([]byte, []int) {
+	return file_marginals_input_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarginalsInput) GetFormulas() []*Formula {
+	if x != nil {
+		return x.Formulas
+	}
+	return nil
+}
+
+func (x *MarginalsInput) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *MarginalsInput) GetProjection() []string {
+	if x != nil {
+		return x.Projection
+	}
+	return nil
+}
+
+func (x *MarginalsInput) GetSolverConfig() *SolverConfig {
+	if x != nil {
+		return x.SolverConfig
+	}
+	return nil
+}
+
+var File_marginals_input_proto protoreflect.FileDescriptor
+
+var file_marginals_input_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x5f, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x73, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0d, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x0e,
+	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2c,
+	0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_marginals_input_proto_rawDescOnce sync.Once
+	file_marginals_input_proto_rawDescData = file_marginals_input_proto_rawDesc
+)
+
+func file_marginals_input_proto_rawDescGZIP() []byte {
+	file_marginals_input_proto_rawDescOnce.Do(func() {
+		file_marginals_input_proto_rawDescData = protoimpl.X.CompressGZIP(file_marginals_input_proto_rawDescData)
+	})
+	return file_marginals_input_proto_rawDescData
+}
+
+var file_marginals_input_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_marginals_input_proto_goTypes = []interface{}{
+	(*MarginalsInput)(nil), // 0: marginalsinput.MarginalsInput
+	(*Formula)(nil),        // 1: formula.Formula
+	(*SolverConfig)(nil),   // 2: solverconfig.SolverConfig
+}
+var file_marginals_input_proto_depIdxs = []int32{
+	1, // 0: marginalsinput.MarginalsInput.formulas:type_name -> formula.Formula
+	2, // 1: marginalsinput.MarginalsInput.solverConfig:type_name -> solverconfig.SolverConfig
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_marginals_input_proto_init() }
+func file_marginals_input_proto_init() {
+	if File_marginals_input_proto != nil {
+		return
+	}
+	file_formula_proto_init()
+	file_solver_config_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_marginals_input_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarginalsInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_marginals_input_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_marginals_input_proto_goTypes,
+		DependencyIndexes: file_marginals_input_proto_depIdxs,
+		MessageInfos:      file_marginals_input_proto_msgTypes,
+	}.Build()
+	File_marginals_input_proto = out.File
+	file_marginals_input_proto_rawDesc = nil
+	file_marginals_input_proto_goTypes = nil
+	file_marginals_input_proto_depIdxs = nil
+}
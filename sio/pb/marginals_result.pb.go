@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: marginals_result.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Marginal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variable string  `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
+	Count    string  `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+	Ratio    float64 `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"`
+}
+
+func (x *Marginal) Reset() {
+	*x = Marginal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marginals_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Marginal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Marginal) ProtoMessage() {}
+
+func (x *Marginal) ProtoReflect() protoreflect.Message {
+	mi := &file_marginals_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Marginal.ProtoReflect.Descriptor instead.
+func (*Marginal) Descriptor() ([]byte, []int) {
+	return file_marginals_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Marginal) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *Marginal) GetCount() string {
+	if x != nil {
+		return x.Count
+	}
+	return ""
+}
+
+func (x *Marginal) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+type MarginalsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State     *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Count     string            `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+	Marginals []*Marginal       `protobuf:"bytes,3,rep,name=marginals,proto3" json:"marginals,omitempty"`
+}
+
+func (x *MarginalsResult) Reset() {
+	*x = MarginalsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marginals_result_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarginalsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginalsResult) ProtoMessage() {}
+
+func (x *MarginalsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_marginals_result_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginalsResult.ProtoReflect.Descriptor instead.
+func (*MarginalsResult) Descriptor() ([]byte, []int) {
+	return file_marginals_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarginalsResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *MarginalsResult) GetCount() string {
+	if x != nil {
+		return x.Count
+	}
+	return ""
+}
+
+func (x *MarginalsResult) GetMarginals() []*Marginal {
+	if x != nil {
+		return x.Marginals
+	}
+	return nil
+}
+
+var File_marginals_result_proto protoreflect.FileDescriptor
+
+var file_marginals_result_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x73, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x08, 0x4d, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x91, 0x01, 0x0a,
+	0x0f, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73,
+	0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e,
+	0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_marginals_result_proto_rawDescOnce sync.Once
+	file_marginals_result_proto_rawDescData = file_marginals_result_proto_rawDesc
+)
+
+func file_marginals_result_proto_rawDescGZIP() []byte {
+	file_marginals_result_proto_rawDescOnce.Do(func() {
+		file_marginals_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_marginals_result_proto_rawDescData)
+	})
+	return file_marginals_result_proto_rawDescData
+}
+
+var file_marginals_result_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_marginals_result_proto_goTypes = []interface{}{
+	(*Marginal)(nil),         // 0: marginalsresult.Marginal
+	(*MarginalsResult)(nil),  // 1: marginalsresult.MarginalsResult
+	(*ComputationState)(nil), // 2: generic.ComputationState
+}
+var file_marginals_result_proto_depIdxs = []int32{
+	2, // 0: marginalsresult.MarginalsResult.state:type_name -> generic.ComputationState
+	0, // 1: marginalsresult.MarginalsResult.marginals:type_name -> marginalsresult.Marginal
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_marginals_result_proto_init() }
+func file_marginals_result_proto_init() {
+	if File_marginals_result_proto != nil {
+		return
+	}
+	file_generic_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_marginals_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Marginal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marginals_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarginalsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_marginals_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_marginals_result_proto_goTypes,
+		DependencyIndexes: file_marginals_result_proto_depIdxs,
+		MessageInfos:      file_marginals_result_proto_msgTypes,
+	}.Build()
+	File_marginals_result_proto = out.File
+	file_marginals_result_proto_rawDesc = nil
+	file_marginals_result_proto_goTypes = nil
+	file_marginals_result_proto_depIdxs = nil
+}
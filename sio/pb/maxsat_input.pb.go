@@ -27,6 +27,7 @@ type MaxSatInput struct {
 
 	HardFormulas []*Formula       `protobuf:"bytes,1,rep,name=hardFormulas,proto3" json:"hardFormulas,omitempty"`
 	SoftFormulas map[string]int64 `protobuf:"bytes,2,rep,name=softFormulas,proto3" json:"softFormulas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Offset       int64            `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
 func (x *MaxSatInput) Reset() {
@@ -75,13 +76,20 @@ func (x *MaxSatInput) GetSoftFormulas() map[string]int64 {
 	return nil
 }
 
+func (x *MaxSatInput) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 var File_maxsat_input_proto protoreflect.FileDescriptor
 
 var file_maxsat_input_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x6d, 0x61, 0x78, 0x73, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x61, 0x78, 0x73, 0x61, 0x74, 0x69, 0x6e, 0x70, 0x75,
 	0x74, 0x1a, 0x0d, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xd4, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x22, 0xec, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
 	0x12, 0x34, 0x0a, 0x0c, 0x68, 0x61, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
 	0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x64, 0x46, 0x6f,
@@ -90,14 +98,16 @@ var file_maxsat_input_proto_rawDesc = []byte{
 	0x61, 0x78, 0x73, 0x61, 0x74, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4d, 0x61, 0x78, 0x53, 0x61,
 	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75,
 	0x6c, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x6f, 0x66, 0x74, 0x46, 0x6f,
-	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x6f, 0x66, 0x74, 0x46, 0x6f,
-	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x1a, 0x3f,
+	0x0a, 0x11, 0x53, 0x6f, 0x66, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f,
+	0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
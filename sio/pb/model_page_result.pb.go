@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: model_page_result.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ModelPageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State             *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Models            []*Formula        `protobuf:"bytes,2,rep,name=models,proto3" json:"models,omitempty"`
+	Truncated         bool              `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Cursor            string            `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count             string            `protobuf:"bytes,5,opt,name=count,proto3" json:"count,omitempty"`
+	FailedAssumptions []string          `protobuf:"bytes,6,rep,name=failedAssumptions,proto3" json:"failedAssumptions,omitempty"`
+}
+
+func (x *ModelPageResult) Reset() {
+	*x = ModelPageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_page_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelPageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelPageResult) ProtoMessage() {}
+
+func (x *ModelPageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_model_page_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelPageResult.ProtoReflect.Descriptor instead.
+func (*ModelPageResult) Descriptor() ([]byte, []int) {
+	return file_model_page_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ModelPageResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ModelPageResult) GetModels() []*Formula {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *ModelPageResult) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ModelPageResult) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ModelPageResult) GetCount() string {
+	if x != nil {
+		return x.Count
+	}
+	return ""
+}
+
+func (x *ModelPageResult) GetFailedAssumptions() []string {
+	if x != nil {
+		return x.FailedAssumptions
+	}
+	return nil
+}
+
+var File_model_page_result_proto protoreflect.FileDescriptor
+
+var file_model_page_result_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x70, 0x61, 0x67, 0x65, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x0d, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x66, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x01, 0x0a, 0x0f, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52,
+	0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73,
+	0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_model_page_result_proto_rawDescOnce sync.Once
+	file_model_page_result_proto_rawDescData = file_model_page_result_proto_rawDesc
+)
+
+func file_model_page_result_proto_rawDescGZIP() []byte {
+	file_model_page_result_proto_rawDescOnce.Do(func() {
+		file_model_page_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_model_page_result_proto_rawDescData)
+	})
+	return file_model_page_result_proto_rawDescData
+}
+
+var file_model_page_result_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_model_page_result_proto_goTypes = []interface{}{
+	(*ModelPageResult)(nil),  // 0: modelpageresult.ModelPageResult
+	(*ComputationState)(nil), // 1: generic.ComputationState
+	(*Formula)(nil),          // 2: formula.Formula
+}
+var file_model_page_result_proto_depIdxs = []int32{
+	1, // 0: modelpageresult.ModelPageResult.state:type_name -> generic.ComputationState
+	2, // 1: modelpageresult.ModelPageResult.models:type_name -> formula.Formula
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_model_page_result_proto_init() }
+func file_model_page_result_proto_init() {
+	if File_model_page_result_proto != nil {
+		return
+	}
+	file_generic_proto_init()
+	file_formula_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_model_page_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelPageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_model_page_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_model_page_result_proto_goTypes,
+		DependencyIndexes: file_model_page_result_proto_depIdxs,
+		MessageInfos:      file_model_page_result_proto_msgTypes,
+	}.Build()
+	File_model_page_result_proto = out.File
+	file_model_page_result_proto_rawDesc = nil
+	file_model_page_result_proto_goTypes = nil
+	file_model_page_result_proto_depIdxs = nil
+}
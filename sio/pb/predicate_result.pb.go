@@ -0,0 +1,180 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: predicate_result.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PredicateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State          *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Value          bool              `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Counterexample []string          `protobuf:"bytes,3,rep,name=counterexample,proto3" json:"counterexample,omitempty"`
+	TrueSide       string            `protobuf:"bytes,4,opt,name=trueSide,proto3" json:"trueSide,omitempty"`
+}
+
+func (x *PredicateResult) Reset() {
+	*x = PredicateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_predicate_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PredicateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredicateResult) ProtoMessage() {}
+
+func (x *PredicateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_predicate_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredicateResult.ProtoReflect.Descriptor instead.
+func (*PredicateResult) Descriptor() ([]byte, []int) {
+	return file_predicate_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PredicateResult) GetState() *ComputationState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *PredicateResult) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *PredicateResult) GetCounterexample() []string {
+	if x != nil {
+		return x.Counterexample
+	}
+	return nil
+}
+
+func (x *PredicateResult) GetTrueSide() string {
+	if x != nil {
+		return x.TrueSide
+	}
+	return ""
+}
+
+var File_predicate_result_proto protoreflect.FileDescriptor
+
+var file_predicate_result_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x72, 0x75, 0x65, 0x53, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x72, 0x75, 0x65, 0x53, 0x69, 0x64, 0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_predicate_result_proto_rawDescOnce sync.Once
+	file_predicate_result_proto_rawDescData = file_predicate_result_proto_rawDesc
+)
+
+func file_predicate_result_proto_rawDescGZIP() []byte {
+	file_predicate_result_proto_rawDescOnce.Do(func() {
+		file_predicate_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_predicate_result_proto_rawDescData)
+	})
+	return file_predicate_result_proto_rawDescData
+}
+
+var file_predicate_result_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_predicate_result_proto_goTypes = []interface{}{
+	(*PredicateResult)(nil),  // 0: predicateresult.PredicateResult
+	(*ComputationState)(nil), // 1: generic.ComputationState
+}
+var file_predicate_result_proto_depIdxs = []int32{
+	1, // 0: predicateresult.PredicateResult.state:type_name -> generic.ComputationState
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_predicate_result_proto_init() }
+func file_predicate_result_proto_init() {
+	if File_predicate_result_proto != nil {
+		return
+	}
+	file_generic_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_predicate_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PredicateResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_predicate_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_predicate_result_proto_goTypes,
+		DependencyIndexes: file_predicate_result_proto_depIdxs,
+		MessageInfos:      file_predicate_result_proto_msgTypes,
+	}.Build()
+	File_predicate_result_proto = out.File
+	file_predicate_result_proto_rawDesc = nil
+	file_predicate_result_proto_goTypes = nil
+	file_predicate_result_proto_depIdxs = nil
+}
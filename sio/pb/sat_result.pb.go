@@ -25,10 +25,11 @@ type SatResult struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State       *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Satisfiable bool              `protobuf:"varint,2,opt,name=satisfiable,proto3" json:"satisfiable,omitempty"`
-	Model       []string          `protobuf:"bytes,3,rep,name=model,proto3" json:"model,omitempty"`
-	UnsatCore   []*Formula        `protobuf:"bytes,4,rep,name=unsatCore,proto3" json:"unsatCore,omitempty"`
+	State             *ComputationState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Satisfiable       bool              `protobuf:"varint,2,opt,name=satisfiable,proto3" json:"satisfiable,omitempty"`
+	Model             []string          `protobuf:"bytes,3,rep,name=model,proto3" json:"model,omitempty"`
+	UnsatCore         []*Formula        `protobuf:"bytes,4,rep,name=unsatCore,proto3" json:"unsatCore,omitempty"`
+	FailedAssumptions []string          `protobuf:"bytes,5,rep,name=failedAssumptions,proto3" json:"failedAssumptions,omitempty"`
 }
 
 func (x *SatResult) Reset() {
@@ -91,13 +92,20 @@ func (x *SatResult) GetUnsatCore() []*Formula {
 	return nil
 }
 
+func (x *SatResult) GetFailedAssumptions() []string {
+	if x != nil {
+		return x.FailedAssumptions
+	}
+	return nil
+}
+
 var File_sat_result_proto protoreflect.FileDescriptor
 
 var file_sat_result_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x73, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x09, 0x73, 0x61, 0x74, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x0d, 0x67,
 	0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x66, 0x6f,
-	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a, 0x09,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x01, 0x0a, 0x09,
 	0x53, 0x61, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61,
 	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72,
 	0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
@@ -108,10 +116,13 @@ var file_sat_result_proto_rawDesc = []byte{
 	0x65, 0x6c, 0x12, 0x2e, 0x0a, 0x09, 0x75, 0x6e, 0x73, 0x61, 0x74, 0x43, 0x6f, 0x72, 0x65, 0x18,
 	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e,
 	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x09, 0x75, 0x6e, 0x73, 0x61, 0x74, 0x43, 0x6f,
-	0x72, 0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69,
-	0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73, 0x75,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e,
+	0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
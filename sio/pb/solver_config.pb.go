@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: solver_config.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SolverConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClauseMinimization string  `protobuf:"bytes,1,opt,name=clauseMinimization,proto3" json:"clauseMinimization,omitempty"`
+	CnfMethod          string  `protobuf:"bytes,2,opt,name=cnfMethod,proto3" json:"cnfMethod,omitempty"`
+	InitialPhase       bool    `protobuf:"varint,3,opt,name=initialPhase,proto3" json:"initialPhase,omitempty"`
+	VarDecay           float64 `protobuf:"fixed64,4,opt,name=varDecay,proto3" json:"varDecay,omitempty"`
+	ClauseDecay        float64 `protobuf:"fixed64,5,opt,name=clauseDecay,proto3" json:"clauseDecay,omitempty"`
+	RestartFactor      float64 `protobuf:"fixed64,6,opt,name=restartFactor,proto3" json:"restartFactor,omitempty"`
+	RestartQueueSize   int32   `protobuf:"varint,7,opt,name=restartQueueSize,proto3" json:"restartQueueSize,omitempty"`
+	BlockingFactor     float64 `protobuf:"fixed64,8,opt,name=blockingFactor,proto3" json:"blockingFactor,omitempty"`
+	BlockingQueueSize  int32   `protobuf:"varint,9,opt,name=blockingQueueSize,proto3" json:"blockingQueueSize,omitempty"`
+	FirstReduceDB      int32   `protobuf:"varint,10,opt,name=firstReduceDB,proto3" json:"firstReduceDB,omitempty"`
+	IncReduceDB        int32   `protobuf:"varint,11,opt,name=incReduceDB,proto3" json:"incReduceDB,omitempty"`
+	FrozenLBD          int32   `protobuf:"varint,12,opt,name=frozenLBD,proto3" json:"frozenLBD,omitempty"`
+	MinimizationLBD    int32   `protobuf:"varint,13,opt,name=minimizationLBD,proto3" json:"minimizationLBD,omitempty"`
+	MinimizationSize   int32   `protobuf:"varint,14,opt,name=minimizationSize,proto3" json:"minimizationSize,omitempty"`
+}
+
+func (x *SolverConfig) Reset() {
+	*x = SolverConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solver_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolverConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolverConfig) ProtoMessage() {}
+
+func (x *SolverConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_solver_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolverConfig.ProtoReflect.Descriptor instead.
+func (*SolverConfig) Descriptor() ([]byte, []int) {
+	return file_solver_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SolverConfig) GetClauseMinimization() string {
+	if x != nil {
+		return x.ClauseMinimization
+	}
+	return ""
+}
+
+func (x *SolverConfig) GetCnfMethod() string {
+	if x != nil {
+		return x.CnfMethod
+	}
+	return ""
+}
+
+func (x *SolverConfig) GetInitialPhase() bool {
+	if x != nil {
+		return x.InitialPhase
+	}
+	return false
+}
+
+func (x *SolverConfig) GetVarDecay() float64 {
+	if x != nil {
+		return x.VarDecay
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetClauseDecay() float64 {
+	if x != nil {
+		return x.ClauseDecay
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetRestartFactor() float64 {
+	if x != nil {
+		return x.RestartFactor
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetRestartQueueSize() int32 {
+	if x != nil {
+		return x.RestartQueueSize
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetBlockingFactor() float64 {
+	if x != nil {
+		return x.BlockingFactor
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetBlockingQueueSize() int32 {
+	if x != nil {
+		return x.BlockingQueueSize
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetFirstReduceDB() int32 {
+	if x != nil {
+		return x.FirstReduceDB
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetIncReduceDB() int32 {
+	if x != nil {
+		return x.IncReduceDB
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetFrozenLBD() int32 {
+	if x != nil {
+		return x.FrozenLBD
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetMinimizationLBD() int32 {
+	if x != nil {
+		return x.MinimizationLBD
+	}
+	return 0
+}
+
+func (x *SolverConfig) GetMinimizationSize() int32 {
+	if x != nil {
+		return x.MinimizationSize
+	}
+	return 0
+}
+
+var File_solver_config_proto protoreflect.FileDescriptor
+
+var file_solver_config_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0xa2, 0x04, 0x0a, 0x0c, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x69,
+	0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6e, 0x66, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6e, 0x66, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x44, 0x65, 0x63,
+	0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x61, 0x72, 0x44, 0x65, 0x63,
+	0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x44, 0x65, 0x63, 0x61,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x44,
+	0x65, 0x63, 0x61, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2c,
+	0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x44, 0x42, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65,
+	0x44, 0x42, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x44,
+	0x42, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x52, 0x65, 0x64, 0x75,
+	0x63, 0x65, 0x44, 0x42, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x4c, 0x42,
+	0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x4c,
+	0x42, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x42, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x42, 0x44, 0x12, 0x2a, 0x0a, 0x10,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x73, 0x69, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_solver_config_proto_rawDescOnce sync.Once
+	file_solver_config_proto_rawDescData = file_solver_config_proto_rawDesc
+)
+
+func file_solver_config_proto_rawDescGZIP() []byte {
+	file_solver_config_proto_rawDescOnce.Do(func() {
+		file_solver_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_solver_config_proto_rawDescData)
+	})
+	return file_solver_config_proto_rawDescData
+}
+
+var file_solver_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_solver_config_proto_goTypes = []interface{}{
+	(*SolverConfig)(nil), // 0: solverconfig.SolverConfig
+}
+var file_solver_config_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_solver_config_proto_init() }
+func file_solver_config_proto_init() {
+	if File_solver_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_solver_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolverConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_solver_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_solver_config_proto_goTypes,
+		DependencyIndexes: file_solver_config_proto_depIdxs,
+		MessageInfos:      file_solver_config_proto_msgTypes,
+	}.Build()
+	File_solver_config_proto = out.File
+	file_solver_config_proto_rawDesc = nil
+	file_solver_config_proto_goTypes = nil
+	file_solver_config_proto_depIdxs = nil
+}
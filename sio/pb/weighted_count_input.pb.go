@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.26.1
+// source: weighted_count_input.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WeightedCountInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Formulas     []*Formula        `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
+	Weights      map[string]string `protobuf:"bytes,2,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SolverConfig *SolverConfig     `protobuf:"bytes,3,opt,name=solverConfig,proto3" json:"solverConfig,omitempty"`
+}
+
+func (x *WeightedCountInput) Reset() {
+	*x = WeightedCountInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weighted_count_input_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeightedCountInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedCountInput) ProtoMessage() {}
+
+func (x *WeightedCountInput) ProtoReflect() protoreflect.Message {
+	mi := &file_weighted_count_input_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedCountInput.ProtoReflect.Descriptor instead.
+func (*WeightedCountInput) Descriptor() ([]byte, []int) {
+	return file_weighted_count_input_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeightedCountInput) GetFormulas() []*Formula {
+	if x != nil {
+		return x.Formulas
+	}
+	return nil
+}
+
+func (x *WeightedCountInput) GetWeights() map[string]string {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *WeightedCountInput) GetSolverConfig() *SolverConfig {
+	if x != nil {
+		return x.SolverConfig
+	}
+	return nil
+}
+
+var File_weighted_count_input_proto protoreflect.FileDescriptor
+
+var file_weighted_count_input_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x0d, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x13, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x02, 0x0a, 0x12, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x66,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52,
+	0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x12, 0x4d, 0x0a, 0x07, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2e,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3a, 0x0a, 0x0c, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69,
+	0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_weighted_count_input_proto_rawDescOnce sync.Once
+	file_weighted_count_input_proto_rawDescData = file_weighted_count_input_proto_rawDesc
+)
+
+func file_weighted_count_input_proto_rawDescGZIP() []byte {
+	file_weighted_count_input_proto_rawDescOnce.Do(func() {
+		file_weighted_count_input_proto_rawDescData = protoimpl.X.CompressGZIP(file_weighted_count_input_proto_rawDescData)
+	})
+	return file_weighted_count_input_proto_rawDescData
+}
+
+var file_weighted_count_input_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_weighted_count_input_proto_goTypes = []interface{}{
+	(*WeightedCountInput)(nil), // 0: weightedcountinput.WeightedCountInput
+	nil,                        // 1: weightedcountinput.WeightedCountInput.WeightsEntry
+	(*Formula)(nil),            // 2: formula.Formula
+	(*SolverConfig)(nil),       // 3: solverconfig.SolverConfig
+}
+var file_weighted_count_input_proto_depIdxs = []int32{
+	2, // 0: weightedcountinput.WeightedCountInput.formulas:type_name -> formula.Formula
+	1, // 1: weightedcountinput.WeightedCountInput.weights:type_name -> weightedcountinput.WeightedCountInput.WeightsEntry
+	3, // 2: weightedcountinput.WeightedCountInput.solverConfig:type_name -> solverconfig.SolverConfig
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_weighted_count_input_proto_init() }
+func file_weighted_count_input_proto_init() {
+	if File_weighted_count_input_proto != nil {
+		return
+	}
+	file_formula_proto_init()
+	file_solver_config_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_weighted_count_input_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeightedCountInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_weighted_count_input_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_weighted_count_input_proto_goTypes,
+		DependencyIndexes: file_weighted_count_input_proto_depIdxs,
+		MessageInfos:      file_weighted_count_input_proto_msgTypes,
+	}.Build()
+	File_weighted_count_input_proto = out.File
+	file_weighted_count_input_proto_rawDesc = nil
+	file_weighted_count_input_proto_goTypes = nil
+	file_weighted_count_input_proto_depIdxs = nil
+}
@@ -0,0 +1,46 @@
+package sio
+
+import (
+	"net/http"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// PredicateResult holds whether a predicate holds.  If it does not hold, the
+// counterexample is an assignment of all variables of the formulas under
+// which it fails.  For an equivalence the true side reports which of the two
+// formulas is true under the counterexample.
+type PredicateResult struct {
+	State          ComputationState `json:"state"`
+	Value          bool             `json:"value"`
+	Counterexample []string         `json:"counterexample,omitempty" example:"A, ~B"`
+	TrueSide       string           `json:"trueSide,omitempty" enums:"first,second" example:"first"`
+}
+
+func (r PredicateResult) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.PredicateResult{
+		State:          r.State.toPB(),
+		Value:          r.Value,
+		Counterexample: r.Counterexample,
+		TrueSide:       r.TrueSide,
+	})
+}
+
+func (PredicateResult) DeserProtoBuf(data []byte) (PredicateResult, error) {
+	result := &pb.PredicateResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return PredicateResult{}, err
+	}
+	return PredicateResult{stateFromPB(result.State), result.Value, result.Counterexample, result.TrueSide}, nil
+}
+
+func WritePredicateResult(w http.ResponseWriter, r *http.Request, value bool, counterexample []string, trueSide string) {
+	result := PredicateResult{
+		State:          successState(r),
+		Value:          value,
+		Counterexample: counterexample,
+		TrueSide:       trueSide,
+	}
+	WriteResult(w, r, result)
+}
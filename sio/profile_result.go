@@ -29,7 +29,7 @@ func (ProfileResult) DeserProtoBuf(data []byte) (ProfileResult, error) {
 
 func WriteProfileResult(w http.ResponseWriter, r *http.Request, profile map[string]int64) {
 	result := ProfileResult{
-		State:   ComputationState{Success: true},
+		State:   successState(r),
 		Profile: profile,
 	}
 	WriteResult(w, r, result)
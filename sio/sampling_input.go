@@ -0,0 +1,34 @@
+package sio
+
+import (
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// SamplingInput holds the formulas and an optional projection.  Without
+// projection the models range over all variables of the formulas.
+type SamplingInput struct {
+	Formulas     []Formula     `json:"formulas"`
+	Projection   []string      `json:"projection,omitempty" example:"A,B,C"`
+	SolverConfig *SolverConfig `json:"solverConfig,omitempty"`
+}
+
+func (i SamplingInput) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.SamplingInput{
+		Formulas:     formulasToPB(i.Formulas),
+		Projection:   i.Projection,
+		SolverConfig: i.SolverConfig.toPB(),
+	})
+}
+
+func (SamplingInput) DeserProtoBuf(data []byte) (SamplingInput, error) {
+	input := &pb.SamplingInput{}
+	if err := proto.Unmarshal(data, input); err != nil {
+		return SamplingInput{}, err
+	}
+	return SamplingInput{formulasFromPB(input.Formulas), input.Projection, solverConfigFromPB(input.SolverConfig)}, nil
+}
+
+func (i SamplingInput) Validate() map[string]string {
+	return FormulaInput{Formulas: i.Formulas, SolverConfig: i.SolverConfig}.Validate()
+}
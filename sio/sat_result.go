@@ -7,11 +7,15 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// SatResult holds the result of a SAT call.  If the formulas are
+// unsatisfiable under the assumptions, the failed assumptions are a minimal
+// subset of the assumptions which is still unsatisfiable.
 type SatResult struct {
-	State       ComputationState `json:"state"`
-	Satisfiable bool             `json:"satisfiable"`
-	Model       []string         `json:"model,omitempty" example:"A, ~B"`
-	UnsatCore   []Formula        `json:"unsatCore,omitempty"`
+	State             ComputationState `json:"state"`
+	Satisfiable       bool             `json:"satisfiable"`
+	Model             []string         `json:"model,omitempty" example:"A, ~B"`
+	UnsatCore         []Formula        `json:"unsatCore,omitempty"`
+	FailedAssumptions []string         `json:"failedAssumptions,omitempty" example:"~B"`
 }
 
 func (r SatResult) ProtoBuf() (bin []byte, err error) {
@@ -20,10 +24,11 @@ func (r SatResult) ProtoBuf() (bin []byte, err error) {
 		core[i] = f.ProtoBuf()
 	}
 	return proto.Marshal(&pb.SatResult{
-		State:       r.State.toPB(),
-		Satisfiable: r.Satisfiable,
-		Model:       r.Model,
-		UnsatCore:   core,
+		State:             r.State.toPB(),
+		Satisfiable:       r.Satisfiable,
+		Model:             r.Model,
+		UnsatCore:         core,
+		FailedAssumptions: r.FailedAssumptions,
 	})
 }
 
@@ -34,17 +39,25 @@ func (SatResult) DeserProtoBuf(data []byte) (SatResult, error) {
 	}
 	core := make([]Formula, len(res.UnsatCore))
 	for i, f := range res.UnsatCore {
-		core[i] = Formula{f.Formula, f.Description}
+		core[i] = formulaFromPB(f)
 	}
-	return SatResult{stateFromPB(res.State), res.Satisfiable, res.Model, core}, nil
+	return SatResult{stateFromPB(res.State), res.Satisfiable, res.Model, core, res.FailedAssumptions}, nil
 }
 
-func WriteSatResult(w http.ResponseWriter, r *http.Request, sat bool, model []string, unsatCore []Formula) {
+func WriteSatResult(
+	w http.ResponseWriter,
+	r *http.Request,
+	sat bool,
+	model []string,
+	unsatCore []Formula,
+	failedAssumptions []string,
+) {
 	result := SatResult{
-		State:       ComputationState{Success: true},
-		Satisfiable: sat,
-		Model:       model,
-		UnsatCore:   unsatCore,
+		State:             successState(r),
+		Satisfiable:       sat,
+		Model:             model,
+		UnsatCore:         unsatCore,
+		FailedAssumptions: failedAssumptions,
 	}
 	WriteResult(w, r, result)
 }
@@ -0,0 +1,363 @@
+package sio
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/booleworks/logicng-go/formula"
+)
+
+type smt2Input[T any] interface {
+	DeserSMT2([]byte) (T, error)
+}
+
+type sexpr struct {
+	atom   string
+	quoted bool
+	list   []sexpr
+	isList bool
+}
+
+func (e sexpr) String() string {
+	if !e.isList {
+		return e.atom
+	}
+	elements := make([]string, len(e.list))
+	for i, el := range e.list {
+		elements[i] = el.String()
+	}
+	return "(" + strings.Join(elements, " ") + ")"
+}
+
+func (e sexpr) isAtom(atom string) bool {
+	return !e.isList && !e.quoted && e.atom == atom
+}
+
+// smt2Term builds a translated SMT-LIB term on the factory of the builder.
+type smt2Term func(b *smt2Builder) formula.Formula
+
+// smt2Builder holds the factory and the formulas of the shared terms which
+// were already built on it.
+type smt2Builder struct {
+	fac    formula.Factory
+	shared map[int]formula.Formula
+}
+
+// smt2Translator translates SMT-LIB terms to builders.  Definitions, let
+// bindings, and compound terms are shared, so each is built only once per
+// factory, no matter how often it is referenced.
+type smt2Translator struct {
+	declared    map[string]bool
+	definitions map[string]smt2Term
+	scopes      []map[string]smt2Term
+	numShared   int
+}
+
+func (FormulaInput) DeserSMT2(data []byte) (FormulaInput, error) {
+	commands, err := parseSExprs(string(data))
+	if err != nil {
+		return FormulaInput{}, err
+	}
+	t := &smt2Translator{declared: make(map[string]bool), definitions: make(map[string]smt2Term)}
+	// all assertions share the built terms on the same factory
+	var b *smt2Builder
+	var formulas []Formula
+	for _, command := range commands {
+		if !command.isList || len(command.list) == 0 || command.list[0].isList {
+			return FormulaInput{}, fmt.Errorf("illegal SMT-LIB command '%s'", command)
+		}
+		args := command.list[1:]
+		switch name := command.list[0].atom; name {
+		case "declare-const":
+			if len(args) != 2 || args[0].isList {
+				return FormulaInput{}, fmt.Errorf("illegal SMT-LIB declaration '%s'", command)
+			}
+			if err := t.declare(args[0].atom, args[1]); err != nil {
+				return FormulaInput{}, err
+			}
+		case "declare-fun":
+			if len(args) != 3 || args[0].isList || !args[1].isList || len(args[1].list) != 0 {
+				return FormulaInput{}, fmt.Errorf("only nullary SMT-LIB functions are supported: '%s'", command)
+			}
+			if err := t.declare(args[0].atom, args[2]); err != nil {
+				return FormulaInput{}, err
+			}
+		case "define-fun":
+			if len(args) != 4 || args[0].isList || !args[1].isList || len(args[1].list) != 0 || !args[2].isAtom("Bool") {
+				return FormulaInput{}, fmt.Errorf("only nullary Boolean SMT-LIB definitions are supported: '%s'", command)
+			}
+			term, err := t.term(args[3])
+			if err != nil {
+				return FormulaInput{}, err
+			}
+			t.definitions[args[0].atom] = t.shared(term)
+		case "assert":
+			if len(args) != 1 {
+				return FormulaInput{}, fmt.Errorf("illegal SMT-LIB assertion '%s'", command)
+			}
+			term, err := t.term(args[0])
+			if err != nil {
+				return FormulaInput{}, err
+			}
+			build := func(fac formula.Factory) formula.Formula {
+				if b == nil || b.fac != fac {
+					b = &smt2Builder{fac: fac, shared: make(map[int]formula.Formula)}
+				}
+				return term(b)
+			}
+			formulas = append(formulas, Formula{Description: smt2Name(args[0]), build: build})
+		case "set-logic", "set-info", "set-option", "check-sat", "check-sat-assuming", "get-model", "get-value",
+			"get-unsat-core", "get-info", "get-option", "echo", "exit":
+		default:
+			return FormulaInput{}, fmt.Errorf("unsupported SMT-LIB command '%s'", name)
+		}
+	}
+	return FormulaInput{Formulas: formulas}, nil
+}
+
+func (t *smt2Translator) declare(name string, sort sexpr) error {
+	if !sort.isAtom("Bool") {
+		return fmt.Errorf("only Boolean SMT-LIB constants are supported, but '%s' has sort '%s'", name, sort)
+	}
+	t.declared[name] = true
+	return nil
+}
+
+// shared returns a term which builds the given term only once per builder.
+func (t *smt2Translator) shared(term smt2Term) smt2Term {
+	id := t.numShared
+	t.numShared++
+	return func(b *smt2Builder) formula.Formula {
+		if f, ok := b.shared[id]; ok {
+			return f
+		}
+		f := term(b)
+		b.shared[id] = f
+		return f
+	}
+}
+
+func (t *smt2Translator) term(e sexpr) (smt2Term, error) {
+	if !e.isList {
+		return t.symbol(e)
+	}
+	if len(e.list) == 0 || e.list[0].isList {
+		return nil, fmt.Errorf("illegal SMT-LIB term '%s'", e)
+	}
+	head := e.list[0].atom
+	switch head {
+	case "let":
+		return t.let(e)
+	case "!":
+		if len(e.list) < 2 {
+			return nil, fmt.Errorf("illegal SMT-LIB annotation '%s'", e)
+		}
+		return t.term(e.list[1])
+	}
+
+	ops := make([]smt2Term, len(e.list)-1)
+	for i, arg := range e.list[1:] {
+		op, err := t.term(arg)
+		if err != nil {
+			return nil, err
+		}
+		ops[i] = op
+	}
+	term, err := t.function(e, head, ops)
+	if err != nil {
+		return nil, err
+	}
+	return t.shared(term), nil
+}
+
+func (t *smt2Translator) function(e sexpr, head string, ops []smt2Term) (smt2Term, error) {
+	switch head {
+	case "not":
+		if len(ops) != 1 {
+			return nil, fmt.Errorf("SMT-LIB 'not' requires one argument: '%s'", e)
+		}
+		return smt2Not(ops[0]), nil
+	case "and":
+		return smt2And(ops...), nil
+	case "or":
+		return func(b *smt2Builder) formula.Formula { return b.fac.Or(buildSMT2Terms(b, ops)...) }, nil
+	case "=>":
+		if len(ops) < 2 {
+			return nil, fmt.Errorf("SMT-LIB '=>' requires at least two arguments: '%s'", e)
+		}
+		result := ops[len(ops)-1]
+		for i := len(ops) - 2; i >= 0; i-- {
+			result = smt2Impl(ops[i], result)
+		}
+		return result, nil
+	case "xor":
+		if len(ops) < 2 {
+			return nil, fmt.Errorf("SMT-LIB 'xor' requires at least two arguments: '%s'", e)
+		}
+		result := ops[0]
+		for _, op := range ops[1:] {
+			result = smt2Not(smt2Equiv(result, op))
+		}
+		return result, nil
+	case "=":
+		if len(ops) < 2 {
+			return nil, fmt.Errorf("SMT-LIB '=' requires at least two arguments: '%s'", e)
+		}
+		equivs := make([]smt2Term, len(ops)-1)
+		for i := range equivs {
+			equivs[i] = smt2Equiv(ops[i], ops[i+1])
+		}
+		if len(equivs) == 1 {
+			return equivs[0], nil
+		}
+		return smt2And(equivs...), nil
+	case "distinct":
+		if len(ops) < 2 {
+			return nil, fmt.Errorf("SMT-LIB 'distinct' requires at least two arguments: '%s'", e)
+		}
+		var pairs []smt2Term
+		for i := range ops {
+			for j := i + 1; j < len(ops); j++ {
+				pairs = append(pairs, smt2Not(smt2Equiv(ops[i], ops[j])))
+			}
+		}
+		if len(pairs) == 1 {
+			return pairs[0], nil
+		}
+		return smt2And(pairs...), nil
+	case "ite":
+		if len(ops) != 3 {
+			return nil, fmt.Errorf("SMT-LIB 'ite' requires three arguments: '%s'", e)
+		}
+		return smt2And(smt2Impl(ops[0], ops[1]), smt2Impl(smt2Not(ops[0]), ops[2])), nil
+	default:
+		return nil, fmt.Errorf("unsupported SMT-LIB function '%s'", head)
+	}
+}
+
+func smt2Not(op smt2Term) smt2Term {
+	return func(b *smt2Builder) formula.Formula { return b.fac.Not(op(b)) }
+}
+
+func smt2Impl(left, right smt2Term) smt2Term {
+	return func(b *smt2Builder) formula.Formula { return b.fac.Implication(left(b), right(b)) }
+}
+
+func smt2Equiv(left, right smt2Term) smt2Term {
+	return func(b *smt2Builder) formula.Formula { return b.fac.Equivalence(left(b), right(b)) }
+}
+
+func smt2And(ops ...smt2Term) smt2Term {
+	return func(b *smt2Builder) formula.Formula { return b.fac.And(buildSMT2Terms(b, ops)...) }
+}
+
+func buildSMT2Terms(b *smt2Builder, terms []smt2Term) []formula.Formula {
+	fs := make([]formula.Formula, len(terms))
+	for i, term := range terms {
+		fs[i] = term(b)
+	}
+	return fs
+}
+
+func (t *smt2Translator) symbol(e sexpr) (smt2Term, error) {
+	if !e.quoted {
+		switch e.atom {
+		case "true":
+			return func(b *smt2Builder) formula.Formula { return b.fac.Verum() }, nil
+		case "false":
+			return func(b *smt2Builder) formula.Formula { return b.fac.Falsum() }, nil
+		}
+	}
+	for i := len(t.scopes) - 1; i >= 0; i-- {
+		if bound, ok := t.scopes[i][e.atom]; ok {
+			return bound, nil
+		}
+	}
+	if defined, ok := t.definitions[e.atom]; ok {
+		return defined, nil
+	}
+	if t.declared[e.atom] {
+		name := e.atom
+		return func(b *smt2Builder) formula.Formula { return b.fac.Variable(name) }, nil
+	}
+	return nil, fmt.Errorf("undeclared SMT-LIB symbol '%s'", e.atom)
+}
+
+func (t *smt2Translator) let(e sexpr) (smt2Term, error) {
+	if len(e.list) != 3 || !e.list[1].isList {
+		return nil, fmt.Errorf("illegal SMT-LIB let '%s'", e)
+	}
+	scope := make(map[string]smt2Term)
+	for _, binding := range e.list[1].list {
+		if !binding.isList || len(binding.list) != 2 || binding.list[0].isList {
+			return nil, fmt.Errorf("illegal SMT-LIB let binding '%s'", binding)
+		}
+		term, err := t.term(binding.list[1])
+		if err != nil {
+			return nil, err
+		}
+		scope[binding.list[0].atom] = t.shared(term)
+	}
+	t.scopes = append(t.scopes, scope)
+	defer func() { t.scopes = t.scopes[:len(t.scopes)-1] }()
+	return t.term(e.list[2])
+}
+
+func smt2Name(e sexpr) string {
+	if !e.isList || len(e.list) == 0 || !e.list[0].isAtom("!") {
+		return ""
+	}
+	for i := 2; i+1 < len(e.list); i += 2 {
+		if e.list[i].isAtom(":named") {
+			return e.list[i+1].atom
+		}
+	}
+	return ""
+}
+
+func parseSExprs(input string) ([]sexpr, error) {
+	var stack [][]sexpr
+	var result []sexpr
+	add := func(e sexpr) {
+		if len(stack) == 0 {
+			result = append(result, e)
+		} else {
+			stack[len(stack)-1] = append(stack[len(stack)-1], e)
+		}
+	}
+	for i := 0; i < len(input); i++ {
+		switch c := input[i]; {
+		case c == ';':
+			for i < len(input) && input[i] != '\n' {
+				i++
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		case c == '(':
+			stack = append(stack, []sexpr{})
+		case c == ')':
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("unbalanced ')' in SMT-LIB input")
+			}
+			list := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			add(sexpr{list: list, isList: true})
+		case c == '|' || c == '"':
+			end := strings.IndexByte(input[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated %c in SMT-LIB input", c)
+			}
+			add(sexpr{atom: input[i+1 : i+1+end], quoted: true})
+			i += end + 1
+		default:
+			start := i
+			for i+1 < len(input) && !strings.ContainsRune(" \t\n\r();|\"", rune(input[i+1])) {
+				i++
+			}
+			add(sexpr{atom: input[start : i+1]})
+		}
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("unbalanced '(' in SMT-LIB input")
+	}
+	return result, nil
+}
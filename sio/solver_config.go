@@ -0,0 +1,167 @@
+package sio
+
+import (
+	"github.com/booleworks/logicng-go/sat"
+	"github.com/booleworks/logicng-service/sio/pb"
+)
+
+// SolverConfig configures all SAT solvers of a computation.  Fields which are
+// not set keep the default of the solver.  The restart parameters control
+// the Glucose-style restarts: a restart is triggered when the average LBD of
+// the last 'restartQueueSize' conflicts times 'restartFactor' exceeds the
+// global average, and it is blocked when the trail is 'blockingFactor' times
+// longer than the average of the last 'blockingQueueSize' conflicts.  The
+// learnt clause database is first reduced after 'firstReduceDB' conflicts,
+// and then in steps growing by 'incReduceDB'.
+type SolverConfig struct {
+	ClauseMinimization string  `json:"clauseMinimization,omitempty" enums:"none,basic,deep" example:"deep"`
+	CNFMethod          string  `json:"cnfMethod,omitempty" enums:"factorization,pg,full-pg" example:"pg"`
+	InitialPhase       bool    `json:"initialPhase,omitempty"`
+	VarDecay           float64 `json:"varDecay,omitempty" example:"0.95"`
+	ClauseDecay        float64 `json:"clauseDecay,omitempty" example:"0.999"`
+	RestartFactor      float64 `json:"restartFactor,omitempty" example:"0.8"`
+	RestartQueueSize   int     `json:"restartQueueSize,omitempty" example:"50"`
+	BlockingFactor     float64 `json:"blockingFactor,omitempty" example:"1.4"`
+	BlockingQueueSize  int     `json:"blockingQueueSize,omitempty" example:"5000"`
+	FirstReduceDB      int     `json:"firstReduceDB,omitempty" example:"2000"`
+	IncReduceDB        int     `json:"incReduceDB,omitempty" example:"300"`
+	FrozenLBD          int     `json:"frozenLBD,omitempty" example:"30"`
+	MinimizationLBD    int     `json:"minimizationLBD,omitempty" example:"6"`
+	MinimizationSize   int     `json:"minimizationSize,omitempty" example:"30"`
+}
+
+var clauseMinimizations = map[string]sat.ClauseMinimization{
+	"none":  sat.ClauseMinNone,
+	"basic": sat.ClauseMinBasic,
+	"deep":  sat.ClauseMinDeep,
+}
+
+var cnfMethods = map[string]sat.CNFMethod{
+	"factorization": sat.CNFFactorization,
+	"pg":            sat.CNFPG,
+	"full-pg":       sat.CNFFullPG,
+}
+
+// SatConfig returns a new SAT solver configuration with the parameters of
+// this configuration.  A nil configuration yields the default configuration.
+func (c *SolverConfig) SatConfig() *sat.Config {
+	cfg := sat.DefaultConfig()
+	if c == nil {
+		return cfg
+	}
+	if c.ClauseMinimization != "" {
+		cfg.ClauseMinimization = clauseMinimizations[c.ClauseMinimization]
+	}
+	if c.CNFMethod != "" {
+		cfg.CNFMethod = cnfMethods[c.CNFMethod]
+	}
+	cfg.InitialPhase = c.InitialPhase
+	ll := cfg.LowLevelConfig
+	setIfPositive(&ll.VarDecay, c.VarDecay)
+	setIfPositive(&ll.ClauseDecay, c.ClauseDecay)
+	setIfPositive(&ll.FactorK, c.RestartFactor)
+	setIfPositive(&ll.SizeLBDQueue, c.RestartQueueSize)
+	setIfPositive(&ll.FactorR, c.BlockingFactor)
+	setIfPositive(&ll.SizeTrailQueue, c.BlockingQueueSize)
+	setIfPositive(&ll.FirstReduceDB, c.FirstReduceDB)
+	setIfPositive(&ll.IncReduceDB, c.IncReduceDB)
+	setIfPositive(&ll.LBLBDFrozenClause, c.FrozenLBD)
+	setIfPositive(&ll.LBLBDMinimizingClause, c.MinimizationLBD)
+	setIfPositive(&ll.LBSizeMinimizingClause, c.MinimizationSize)
+	if ll.MaxVarDecay < ll.VarDecay {
+		ll.MaxVarDecay = ll.VarDecay
+	}
+	return cfg
+}
+
+func setIfPositive[T int | float64](field *T, value T) {
+	if value > 0 {
+		*field = value
+	}
+}
+
+func (c *SolverConfig) validate() map[string]string {
+	if c == nil {
+		return nil
+	}
+	if _, ok := clauseMinimizations[c.ClauseMinimization]; !ok && c.ClauseMinimization != "" {
+		return map[string]string{"solverConfig.clauseMinimization": "unknown clause minimization"}
+	}
+	if _, ok := cnfMethods[c.CNFMethod]; !ok && c.CNFMethod != "" {
+		return map[string]string{"solverConfig.cnfMethod": "unknown CNF method"}
+	}
+	if c.VarDecay < 0 || c.VarDecay >= 1 {
+		return map[string]string{"solverConfig.varDecay": "must be in (0, 1)"}
+	}
+	if c.ClauseDecay < 0 || c.ClauseDecay >= 1 {
+		return map[string]string{"solverConfig.clauseDecay": "must be in (0, 1)"}
+	}
+	if c.RestartFactor < 0 || c.RestartFactor > 1 {
+		return map[string]string{"solverConfig.restartFactor": "must be in (0, 1]"}
+	}
+	if c.BlockingFactor < 0 {
+		return map[string]string{"solverConfig.blockingFactor": "must be > 0"}
+	}
+	sizes := []struct {
+		name string
+		size int
+	}{
+		{"restartQueueSize", c.RestartQueueSize},
+		{"blockingQueueSize", c.BlockingQueueSize},
+		{"firstReduceDB", c.FirstReduceDB},
+		{"incReduceDB", c.IncReduceDB},
+		{"frozenLBD", c.FrozenLBD},
+		{"minimizationLBD", c.MinimizationLBD},
+		{"minimizationSize", c.MinimizationSize},
+	}
+	for _, s := range sizes {
+		if s.size < 0 {
+			return map[string]string{"solverConfig." + s.name: "must be > 0"}
+		}
+	}
+	return nil
+}
+
+func (c *SolverConfig) toPB() *pb.SolverConfig {
+	if c == nil {
+		return nil
+	}
+	return &pb.SolverConfig{
+		ClauseMinimization: c.ClauseMinimization,
+		CnfMethod:          c.CNFMethod,
+		InitialPhase:       c.InitialPhase,
+		VarDecay:           c.VarDecay,
+		ClauseDecay:        c.ClauseDecay,
+		RestartFactor:      c.RestartFactor,
+		RestartQueueSize:   int32(c.RestartQueueSize),
+		BlockingFactor:     c.BlockingFactor,
+		BlockingQueueSize:  int32(c.BlockingQueueSize),
+		FirstReduceDB:      int32(c.FirstReduceDB),
+		IncReduceDB:        int32(c.IncReduceDB),
+		FrozenLBD:          int32(c.FrozenLBD),
+		MinimizationLBD:    int32(c.MinimizationLBD),
+		MinimizationSize:   int32(c.MinimizationSize),
+	}
+}
+
+func solverConfigFromPB(c *pb.SolverConfig) *SolverConfig {
+	if c == nil {
+		return nil
+	}
+	return &SolverConfig{
+		ClauseMinimization: c.ClauseMinimization,
+		CNFMethod:          c.CnfMethod,
+		InitialPhase:       c.InitialPhase,
+		VarDecay:           c.VarDecay,
+		ClauseDecay:        c.ClauseDecay,
+		RestartFactor:      c.RestartFactor,
+		RestartQueueSize:   int(c.RestartQueueSize),
+		BlockingFactor:     c.BlockingFactor,
+		BlockingQueueSize:  int(c.BlockingQueueSize),
+		FirstReduceDB:      int(c.FirstReduceDB),
+		IncReduceDB:        int(c.IncReduceDB),
+		FrozenLBD:          int(c.FrozenLBD),
+		MinimizationLBD:    int(c.MinimizationLBD),
+		MinimizationSize:   int(c.MinimizationSize),
+	}
+}
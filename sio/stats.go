@@ -0,0 +1,139 @@
+package sio
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/booleworks/logicng-go/bdd"
+	"github.com/booleworks/logicng-go/formula"
+	"github.com/booleworks/logicng-go/sat"
+	"github.com/booleworks/logicng-service/sio/pb"
+)
+
+// Stats holds the statistics of a computation which are reported in the
+// state of the result with the query parameter 'stats=true'.  Times are given
+// in microseconds, the compute time is the time of the request without the
+// parse time.  The counters are only set by the computations which have them:
+// variables and formula nodes of the parsed input, clauses, conflicts, BDD
+// nodes, DNNF nodes, and MaxSAT iterations (SAT calls of the algorithm).
+type Stats struct {
+	ParseMicros      int64 `json:"parseMicros"`
+	ComputeMicros    int64 `json:"computeMicros"`
+	Variables        int   `json:"variables,omitempty"`
+	Nodes            int   `json:"nodes,omitempty"`
+	Clauses          int   `json:"clauses,omitempty"`
+	Conflicts        int   `json:"conflicts,omitempty"`
+	BDDNodes         int   `json:"bddNodes,omitempty"`
+	DNNFSize         int   `json:"dnnfSize,omitempty"`
+	MaxSatIterations int   `json:"maxSatIterations,omitempty"`
+
+	start time.Time
+	parse time.Duration
+	vars  *formula.MutableVarSet
+}
+
+// NewStats returns new statistics for a computation which starts now.
+func NewStats() *Stats {
+	return &Stats{start: time.Now(), vars: formula.NewMutableVarSet()}
+}
+
+// StatsOf returns the statistics of the request, or nil if they are not
+// requested.  All methods of Stats can be called on nil.
+func StatsOf(r *http.Request) *Stats {
+	if state, ok := r.Context().Value(State{}).(*ComputationState); ok {
+		return state.Stats
+	}
+	return nil
+}
+
+// AddParseTime adds the time since the given start to the parse time.
+func (s *Stats) AddParseTime(start time.Time) {
+	if s != nil {
+		s.parse += time.Since(start)
+	}
+}
+
+// AddFormula records the variables and nodes of a parsed input formula.
+func (s *Stats) AddFormula(fac formula.Factory, f formula.Formula) {
+	if s != nil {
+		s.vars.AddAll(formula.Variables(fac, f))
+		s.Variables = s.vars.Size()
+		s.Nodes += formula.NumberOfNodes(fac, f)
+	}
+}
+
+// AddConflict counts a conflict of a SAT solver.
+func (s *Stats) AddConflict() {
+	if s != nil {
+		s.Conflicts++
+	}
+}
+
+// AddMaxSatIteration counts a SAT call of a MaxSAT algorithm.
+func (s *Stats) AddMaxSatIteration() {
+	if s != nil {
+		s.MaxSatIterations++
+	}
+}
+
+// SetSolver records the number of clauses on the SAT solver.
+func (s *Stats) SetSolver(solver *sat.Solver) {
+	if s != nil {
+		s.Clauses = len(solver.FormulasOnSolver())
+	}
+}
+
+// SetBDD records the number of nodes of the computed BDD.
+func (s *Stats) SetBDD(b *bdd.BDD) {
+	if s != nil {
+		s.BDDNodes = b.NodeCount()
+	}
+}
+
+// SetDNNF records the number of distinct nodes of the computed DNNF.
+func (s *Stats) SetDNNF(fac formula.Factory, dnnf formula.Formula) {
+	if s != nil {
+		s.DNNFSize = len(formula.SubNodes(fac, dnnf))
+	}
+}
+
+func (s *Stats) finish() {
+	if s != nil {
+		s.ParseMicros = s.parse.Microseconds()
+		s.ComputeMicros = (time.Since(s.start) - s.parse).Microseconds()
+	}
+}
+
+func (s *Stats) toPB() *pb.Stats {
+	if s == nil {
+		return nil
+	}
+	return &pb.Stats{
+		ParseMicros:      s.ParseMicros,
+		ComputeMicros:    s.ComputeMicros,
+		Variables:        int32(s.Variables),
+		Nodes:            int32(s.Nodes),
+		Clauses:          int32(s.Clauses),
+		Conflicts:        int32(s.Conflicts),
+		BddNodes:         int32(s.BDDNodes),
+		DnnfSize:         int32(s.DNNFSize),
+		MaxSatIterations: int32(s.MaxSatIterations),
+	}
+}
+
+func statsFromPB(s *pb.Stats) *Stats {
+	if s == nil {
+		return nil
+	}
+	return &Stats{
+		ParseMicros:      s.ParseMicros,
+		ComputeMicros:    s.ComputeMicros,
+		Variables:        int(s.Variables),
+		Nodes:            int(s.Nodes),
+		Clauses:          int(s.Clauses),
+		Conflicts:        int(s.Conflicts),
+		BDDNodes:         int(s.BddNodes),
+		DNNFSize:         int(s.DnnfSize),
+		MaxSatIterations: int(s.MaxSatIterations),
+	}
+}
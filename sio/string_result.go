@@ -8,14 +8,16 @@ import (
 )
 
 type StringResult struct {
-	State ComputationState `json:"state"`
-	Value string           `json:"value"`
+	State             ComputationState `json:"state"`
+	Value             string           `json:"value"`
+	FailedAssumptions []string         `json:"failedAssumptions,omitempty" example:"~B"`
 }
 
 func (r StringResult) ProtoBuf() ([]byte, error) {
 	return proto.Marshal(&pb.StringResult{
-		State: r.State.toPB(),
-		Value: r.Value,
+		State:             r.State.toPB(),
+		Value:             r.Value,
+		FailedAssumptions: r.FailedAssumptions,
 	})
 }
 
@@ -24,17 +26,28 @@ func (StringResult) DeserProtoBuf(data []byte) (StringResult, error) {
 	if err := proto.Unmarshal(data, result); err != nil {
 		return StringResult{}, err
 	}
-	return StringResult{stateFromPB(result.State), result.Value}, nil
+	return StringResult{stateFromPB(result.State), result.Value, result.FailedAssumptions}, nil
 }
 
 func WriteStringResult(w http.ResponseWriter, r *http.Request, value string) {
 	result := StringResult{
-		State: ComputationState{Success: true},
+		State: successState(r),
 		Value: value,
 	}
 	WriteResult(w, r, result)
 }
 
+// WriteUnsatStringResult writes the value for formulas which are
+// unsatisfiable under their assumptions together with the failed assumptions.
+func WriteUnsatStringResult(w http.ResponseWriter, r *http.Request, value string, failedAssumptions []string) {
+	result := StringResult{
+		State:             successState(r),
+		Value:             value,
+		FailedAssumptions: failedAssumptions,
+	}
+	WriteResult(w, r, result)
+}
+
 func WriteStringResultAsText(w http.ResponseWriter, r *http.Request, value string) {
 	WriteTextResult(w, r, value)
 }
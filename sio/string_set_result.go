@@ -28,7 +28,7 @@ func (StringSetResult) DeserProtoBuf(data []byte) (StringSetResult, error) {
 
 func WriteStringSetResult(w http.ResponseWriter, r *http.Request, values []string) {
 	result := StringSetResult{
-		State:  ComputationState{Success: true},
+		State:  successState(r),
 		Values: values,
 	}
 	WriteResult(w, r, result)
@@ -25,7 +25,7 @@ func (SubstitutionInput) DeserProtoBuf(data []byte) (SubstitutionInput, error) {
 	}
 	formulas := make([]Formula, len(input.Formulas))
 	for i, f := range input.Formulas {
-		formulas[i] = Formula{f.Formula, f.Description}
+		formulas[i] = formulaFromPB(f)
 	}
 	return SubstitutionInput{Formulas: formulas, Substitution: input.Substitution}, nil
 }
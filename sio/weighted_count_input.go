@@ -0,0 +1,49 @@
+package sio
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// WeightedCountInput holds the formulas and the weights of their literals.
+// The weights are keyed by the literal ('A' or '~A') and given as rational
+// ('1/3') or decimal ('0.25') numbers.  Literals without a weight have weight 1.
+type WeightedCountInput struct {
+	Formulas     []Formula         `json:"formulas"`
+	Weights      map[string]string `json:"weights" example:"A:1/3,~A:2/3,B:0.25"`
+	SolverConfig *SolverConfig     `json:"solverConfig,omitempty"`
+}
+
+func (i WeightedCountInput) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.WeightedCountInput{
+		Formulas:     formulasToPB(i.Formulas),
+		Weights:      i.Weights,
+		SolverConfig: i.SolverConfig.toPB(),
+	})
+}
+
+func (WeightedCountInput) DeserProtoBuf(data []byte) (WeightedCountInput, error) {
+	input := &pb.WeightedCountInput{}
+	if err := proto.Unmarshal(data, input); err != nil {
+		return WeightedCountInput{}, err
+	}
+	return WeightedCountInput{formulasFromPB(input.Formulas), input.Weights, solverConfigFromPB(input.SolverConfig)}, nil
+}
+
+func (i WeightedCountInput) Validate() map[string]string {
+	if errs := (FormulaInput{Formulas: i.Formulas, SolverConfig: i.SolverConfig}).Validate(); errs != nil {
+		return errs
+	}
+	for lit, weight := range i.Weights {
+		if strings.TrimSpace(strings.TrimPrefix(lit, "~")) == "" {
+			return map[string]string{"weights": "contains empty literal"}
+		}
+		if _, ok := new(big.Rat).SetString(weight); !ok {
+			return map[string]string{"weights": "illegal weight '" + weight + "' for literal " + lit}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package sio
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/booleworks/logicng-service/sio/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+type WeightedCountResult struct {
+	State         ComputationState `json:"state"`
+	Value         string           `json:"value" example:"5/12"`
+	Approximation float64          `json:"approximation" example:"0.4166666666666667"`
+}
+
+func (r WeightedCountResult) ProtoBuf() ([]byte, error) {
+	return proto.Marshal(&pb.WeightedCountResult{
+		State:         r.State.toPB(),
+		Value:         r.Value,
+		Approximation: r.Approximation,
+	})
+}
+
+func (WeightedCountResult) DeserProtoBuf(data []byte) (WeightedCountResult, error) {
+	result := &pb.WeightedCountResult{}
+	if err := proto.Unmarshal(data, result); err != nil {
+		return WeightedCountResult{}, err
+	}
+	return WeightedCountResult{stateFromPB(result.State), result.Value, result.Approximation}, nil
+}
+
+func WriteWeightedCountResult(w http.ResponseWriter, r *http.Request, value *big.Rat) {
+	approximation, _ := value.Float64()
+	result := WeightedCountResult{
+		State:         successState(r),
+		Value:         value.RatString(),
+		Approximation: approximation,
+	}
+	WriteResult(w, r, result)
+}
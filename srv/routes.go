@@ -12,21 +12,31 @@ func addRoutes(
 	mux *http.ServeMux,
 	cfg *config.Config,
 ) {
+	mux.Handle("POST /analysis/diff", computation.HandleDiff(cfg))
 	mux.Handle("POST /assignment/{ass}", computation.HandleAssignment(cfg))
 	mux.Handle("POST /bdd/compilation", computation.HandleBDDCompilation(cfg))
 	mux.Handle("POST /bdd/graphical", computation.HandleBDDGraphical(cfg))
+	mux.Handle("POST /bdd/ordering", computation.HandleBDDOrdering(cfg))
+	mux.Handle("POST /bdd/operation/{op}", computation.HandleBDDOperation(cfg))
+	mux.Handle("POST /bdd/query/{query}", computation.HandleBDDQuery(cfg))
 	mux.Handle("POST /dnnf/compilation", computation.HandleDNNFCompilation(cfg))
 	mux.Handle("POST /encoding/{enc}", computation.HandleEncoding(cfg))
 	mux.Handle("POST /explanation/mus", computation.HandleMUS(cfg))
 	mux.Handle("POST /explanation/smus", computation.HandleSMUS(cfg))
+	mux.Handle("POST /explanation/redundancy", computation.HandleRedundancy(cfg))
 	mux.Handle("POST /formula/{func}", computation.HandleFormula(cfg))
+	mux.Handle("POST /formula/export/{format}", computation.HandleFormulaExport(cfg))
 	mux.Handle("POST /graph/constraint", computation.HandleConstraintGraph(cfg))
 	mux.Handle("POST /graph/constraint/graphical", computation.HandleConstraintGraphGraphical(cfg))
 	mux.Handle("POST /graph/components", computation.HandleGraphComponents(cfg))
 	mux.Handle("POST /model/counting", computation.HandleModelCounting(cfg))
 	mux.Handle("POST /model/counting/projection", computation.HandleProjectedModelCounting(cfg))
+	mux.Handle("POST /model/counting/weighted", computation.HandleWeightedModelCounting(cfg))
 	mux.Handle("POST /model/enumeration", computation.HandleModelEnumeration(cfg))
 	mux.Handle("POST /model/enumeration/projection", computation.HandleProjectedModelEnumeration(cfg))
+	mux.Handle("POST /model/marginals", computation.HandleMarginals(cfg))
+	mux.Handle("POST /model/sampling", computation.HandleModelSampling(cfg))
+	mux.Handle("POST /model/sampling/weighted", computation.HandleWeightedModelSampling(cfg))
 	mux.Handle("POST /normalform/transformation/{nf}", computation.HandleNFTrans(cfg))
 	mux.Handle("POST /normalform/predicate/{nf}", computation.HandleNFPred(cfg))
 	mux.Handle("POST /prime/minimal-implicant", computation.HandleMinimalImplicant(cfg))
@@ -36,6 +46,7 @@ func addRoutes(
 	mux.Handle("POST /solver/sat", computation.HandleSat(cfg))
 	mux.Handle("POST /solver/predicate/{pred}", computation.HandleSatPredicate(cfg))
 	mux.Handle("POST /solver/backbone", computation.HandleSatBackbone(cfg))
+	mux.Handle("POST /solver/relations", computation.HandleSatRelations(cfg))
 	mux.Handle("POST /substitution/{subst}", computation.HandleSubstitution(cfg))
 
 	mux.Handle("GET /randomizer/{rand}", computation.HandleRandomizer(cfg))
@@ -0,0 +1,119 @@
+package test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/booleworks/logicng-service/sio"
+	"github.com/stretchr/testify/assert"
+)
+
+var diffInput = sio.DiffInput{
+	Old: []sio.Formula{
+		{Formula: "A => B", Description: "r1"},
+		{Formula: "C", Description: "r2"},
+		{Formula: "B | D", Description: "r3"},
+	},
+	New: []sio.Formula{
+		{Formula: "A => B", Description: "r1"},
+		{Formula: "B | D", Description: "r3"},
+		{Formula: "A", Description: "r4"},
+		{Formula: "~D", Description: "r5"},
+	},
+}
+
+func TestDiff(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	input, _ := json.Marshal(diffInput)
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("analysis/diff"), string(input))
+	assert.Nil(err)
+	var result sio.DiffResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	validateDiff(t, result)
+	assert.Equal("4", result.Lost.Count)
+	assert.Equal(3, len(result.Lost.Cubes))
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("analysis/diff?limit=1"), string(input))
+	assert.Nil(err)
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.Equal("4", result.Lost.Count)
+	assert.Equal(1, len(result.Lost.Cubes))
+}
+
+func TestDiffProtoBuf(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	input, _ := diffInput.ProtoBuf()
+	response, err := callServiceProtoBuf(ctx, http.MethodPost, endpoint("analysis/diff"), input)
+	assert.Nil(err)
+	validateSuccess(t, response, "application/protobuf")
+	data, err := io.ReadAll(response.Body)
+	assert.Nil(err)
+	result, err := sio.DiffResult{}.DeserProtoBuf(data)
+	assert.Nil(err)
+	validateDiff(t, result)
+}
+
+func validateDiff(t *testing.T, result sio.DiffResult) {
+	assert := assert.New(t)
+	descriptions := func(fs []sio.Formula) []string {
+		result := make([]string, len(fs))
+		for i, f := range fs {
+			result[i] = f.Description
+		}
+		return result
+	}
+	assert.True(result.State.Success)
+	assert.Equal([]string{"A", "B", "C", "D"}, result.Variables)
+	assert.Equal([]string{"r4", "r5"}, descriptions(result.Added))
+	assert.Equal([]string{"r2"}, descriptions(result.Removed))
+
+	assert.Equal("1", result.Gained.Count)
+	assert.Equal([]sio.Cube{{Literals: []string{"A", "B", "~C", "~D"}, Count: "1"}}, result.Gained.Cubes)
+	assert.Equal([]string{"r2"}, descriptions(result.Gained.Causes))
+	assert.Equal([]string{"r4", "r5"}, descriptions(result.Lost.Causes))
+
+	assert.Equal(4, len(result.Backbone))
+	expected := []struct {
+		literal, change string
+		causes          []string
+	}{
+		{"A", "added", []string{"r4"}},
+		{"B", "added", []string{"r5"}},
+		{"C", "removed", []string{"r2"}},
+		{"~D", "added", []string{"r5"}},
+	}
+	for i, e := range expected {
+		if i < len(result.Backbone) {
+			assert.Equal(e.literal, result.Backbone[i].Literal)
+			assert.Equal(e.change, result.Backbone[i].Change)
+			assert.Equal(e.causes, descriptions(result.Backbone[i].Causes))
+		}
+	}
+	assert.Equal([]string{"A", "B"}, result.Forced)
+	assert.Equal([]string{"D"}, result.Dead)
+}
+
+func TestDiffCardinalityConstraint(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	input := `{"old": [{"formula": "A + B + C + D + E + F <= 2"}], "new": [{"formula": "A + B + C + D + E + F <= 1"}]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("analysis/diff?limit=20"), input)
+	assert.Nil(err)
+	var result sio.DiffResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.True(result.State.Success)
+	assert.Equal([]string{"A", "B", "C", "D", "E", "F"}, result.Variables)
+	assert.Equal("0", result.Gained.Count)
+	assert.Empty(result.Gained.Cubes)
+	assert.Equal("15", result.Lost.Count)
+	assert.Equal(15, len(result.Lost.Cubes))
+	for _, cube := range result.Lost.Cubes {
+		assert.Equal(6, len(cube.Literals))
+		assert.Equal("1", cube.Count)
+	}
+	assert.Empty(result.Backbone)
+}
@@ -0,0 +1,156 @@
+package test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/booleworks/logicng-service/sio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestASTInput(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	ep := endpoint("normalform/transformation/nnf")
+	input := `
+    {
+      "formulas": [
+        {"ast": {"op": "not", "ops": [
+          {"op": "equiv", "ops": [
+            {"op": "impl", "ops": [
+              {"op": "and", "ops": [{"op": "var", "name": "A"}, {"op": "var", "name": "B"}]},
+              {"op": "not", "ops": [{"op": "var", "name": "C"}]}
+            ]},
+            {"op": "var", "name": "D"}
+          ]}
+        ]}}
+      ]
+    }
+	`
+	response, err := callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "(A & B & C | ~D) & (~A | ~B | ~C | D)")
+
+	ep = endpoint("formula/variables")
+	input = `
+    {
+      "formulas": [
+        {"ast": {"op": "cc", "vars": ["A", "B", "C"], "comparator": "<=", "rhs": 1}},
+        {"ast": {"op": "pbc", "lits": ["A", "~X"], "coefficients": [2, -3], "comparator": ">=", "rhs": -1}}
+      ]
+    }
+	`
+	response, err = callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body := extractJSONBody(response)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "values": [
+    "A",
+    "B",
+    "C",
+    "X"
+  ]
+}
+`, body)
+}
+
+func TestASTOutput(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	ep := endpoint("assignment/restriction?format=ast")
+	input := `
+    {
+      "formulas": [
+        {"formula": "~(A => B) & (Z | $false)", "description": "first"},
+        {"formula": "A + B + C <= 1"},
+        {"formula": "2*A + -3*~X >= -1"}
+      ],
+      "assignment": {"Z": true}
+    }
+	`
+	response, err := callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body := extractJSONBody(response)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "formulas": [
+    {
+      "description": "first",
+      "ast": {
+        "op": "not",
+        "ops": [
+          {
+            "op": "impl",
+            "ops": [
+              {
+                "op": "var",
+                "name": "A"
+              },
+              {
+                "op": "var",
+                "name": "B"
+              }
+            ]
+          }
+        ]
+      }
+    },
+    {
+      "ast": {
+        "op": "cc",
+        "vars": [
+          "A",
+          "B",
+          "C"
+        ],
+        "comparator": "<=",
+        "rhs": 1
+      }
+    },
+    {
+      "ast": {
+        "op": "pbc",
+        "lits": [
+          "A",
+          "~X"
+        ],
+        "coefficients": [
+          2,
+          -3
+        ],
+        "comparator": ">=",
+        "rhs": -1
+      }
+    }
+  ]
+}
+`, body)
+}
+
+func TestASTProtoBuf(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	ep := endpoint("simplification/unitpropagation?format=ast")
+	ast := &sio.FormulaAST{Op: "and", Ops: []sio.FormulaAST{
+		{Op: "var", Name: "A"},
+		{Op: "or", Ops: []sio.FormulaAST{{Op: "not", Ops: []sio.FormulaAST{{Op: "var", Name: "A"}}}, {Op: "var", Name: "B"}}},
+	}}
+	input := sio.FormulaInput{Formulas: []sio.Formula{{AST: ast, Description: "desc"}}}
+	bin, _ := input.ProtoBuf()
+	response, err := callServiceProtoBuf(ctx, http.MethodPost, ep, bin)
+	assert.Nil(err)
+	validateSuccess(t, response, "application/protobuf")
+	data, err := io.ReadAll(response.Body)
+	assert.Nil(err)
+	result, err := sio.FormulaResult{}.DeserProtoBuf(data)
+	assert.Nil(err)
+	assert.Equal(&sio.FormulaAST{Op: "and", Ops: []sio.FormulaAST{{Op: "var", Name: "A"}, {Op: "var", Name: "B"}}},
+		result.Formulas[0].AST)
+	assert.Empty(result.Formulas[0].Formula)
+}
@@ -0,0 +1,292 @@
+package test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/booleworks/logicng-service/sio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBDDSerialization(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := jsonFormulaInput("(A | B) & (A | C)")
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("bdd/compilation?ordering=dfs&output=bdd"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "bdd": {
+    "order": [
+      "A",
+      "B",
+      "C"
+    ],
+    "nodes": [
+      {
+        "id": 2,
+        "variable": "C",
+        "low": 0,
+        "high": 1
+      },
+      {
+        "id": 3,
+        "variable": "B",
+        "low": 0,
+        "high": 2
+      },
+      {
+        "id": 4,
+        "variable": "A",
+        "low": 3,
+        "high": 1
+      }
+    ],
+    "root": 4
+  }
+}
+`, extractJSONBody(response))
+
+	input = `{"bdd": {"order": ["A", "B", "C"], "nodes": [
+	  {"id": 2, "variable": "C", "low": 0, "high": 1},
+	  {"id": 3, "variable": "B", "low": 0, "high": 2},
+	  {"id": 4, "variable": "A", "low": 3, "high": 1}
+	], "root": 4}}`
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/query/model-count"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "value": "5"
+}
+`, extractJSONBody(response))
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/query/formula"), input)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "~A & B & C | A")
+}
+
+func TestBDDSerializationProtoBuf(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	response, err := callServiceProtoBuf(ctx, http.MethodPost, endpoint("bdd/compilation?output=bdd"), pbFormulaInput("A & ~B | C"))
+	assert.Nil(err)
+	data, err := io.ReadAll(response.Body)
+	assert.Nil(err)
+	result, err := sio.BDDResult{}.DeserProtoBuf(data)
+	assert.Nil(err)
+	assert.True(result.State.Success)
+
+	input, err := sio.BDDInput{BDD: result.BDD}.ProtoBuf()
+	assert.Nil(err)
+	response, err = callServiceProtoBuf(ctx, http.MethodPost, endpoint("bdd/query/formula"), input)
+	assert.Nil(err)
+	validateProtoBufFormulaResult(t, response, "~A & C | A & (~C & ~B | C)")
+}
+
+func TestBDDQueries(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := `{"formulas": [{"formula": "(A | B) & (A | C)"}], "variables": ["A", "B", "C"]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("bdd/query/node-count?ordering=dfs"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "value": 3
+}
+`, extractJSONBody(response))
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/query/support"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "values": [
+    "A",
+    "B",
+    "C"
+  ]
+}
+`, extractJSONBody(response))
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/query/variable-profile?ordering=dfs"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "profile": {
+    "A": 1,
+    "B": 1,
+    "C": 1
+  }
+}
+`, extractJSONBody(response))
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/query/model?ordering=dfs"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "satisfiable": true,
+  "model": [
+    "C",
+    "B",
+    "~A"
+  ]
+}
+`, extractJSONBody(response))
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/query/enumeration?ordering=dfs"), input)
+	assert.Nil(err)
+	validateSuccess(t, response, "application/json")
+	var result sio.FormulaResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	models := make([]string, len(result.Formulas))
+	for i, f := range result.Formulas {
+		models[i] = f.Formula
+	}
+	assert.Equal([]string{"~A & B & C", "A & ~B & ~C", "A & ~B & C", "A & B & ~C", "A & B & C"}, models)
+}
+
+func TestBDDOperations(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	operands := `{"formulas": [{"formula": "A | B"}], "operand": {"formulas": [{"formula": "A => C"}]}}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("bdd/operation/and?output=formula"), operands)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "~B & A & C | B & (~A | A & C)")
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/operation/or?output=formula"), operands)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "$true")
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/operation/xor?output=formula"), operands)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "~B & (~A | A & ~C) | B & A & ~C")
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/operation/implies?output=formula"), operands)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "~A | A & C")
+
+	input := `{"formulas": [{"formula": "(A | B) & (A | C)"}], "assignment": {"A": false}}`
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/operation/restrict?output=formula"), input)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "B & C")
+
+	input = `{"formulas": [{"formula": "(A | B) & (A | C)"}], "variables": ["A"]}`
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/operation/exists?output=formula"), input)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "$true")
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/operation/forall"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "bdd": {
+    "order": [
+      "B",
+      "A",
+      "C"
+    ],
+    "nodes": [
+      {
+        "id": 2,
+        "variable": "C",
+        "low": 0,
+        "high": 1
+      },
+      {
+        "id": 3,
+        "variable": "B",
+        "low": 0,
+        "high": 2
+      }
+    ],
+    "root": 3
+  }
+}
+`, extractJSONBody(response))
+}
+
+func TestBDDOrdering(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := `{"formulas": [{"formula": "(A1 <=> B1) & (A2 <=> B2) & (A3 <=> B3)"}], "order": ["A1", "A2", "A3"]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("bdd/ordering"), input)
+	assert.Nil(err)
+	validateSuccess(t, response, "application/json")
+	var result sio.BDDOrderingResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	interleaved := []string{"A1", "B1", "A2", "B2", "A3", "B3"}
+	assert.Equal([]sio.BDDOrdering{
+		{Ordering: "bfs", Order: interleaved, NodeCount: 9},
+		{Ordering: "dfs", Order: interleaved, NodeCount: 9},
+		{Ordering: "min2max", Order: interleaved, NodeCount: 9},
+		{Ordering: "max2min", Order: interleaved, NodeCount: 9},
+		{Ordering: "force", Order: interleaved, NodeCount: 9},
+		{Ordering: "explicit", Order: []string{"A1", "A2", "A3", "B1", "B2", "B3"}, NodeCount: 21},
+	}, result.Orderings)
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("bdd/ordering?reordering=sift&nodeTableSize=5"), input)
+	assert.Nil(err)
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	reordered := result.Orderings[5]
+	assert.Equal("explicit", reordered.Ordering)
+	assert.ElementsMatch([]string{"A1", "A2", "A3", "B1", "B2", "B3"}, reordered.Order)
+	assert.LessOrEqual(reordered.NodeCount, int64(21))
+}
+
+func TestBDDCompilationExplicitOrder(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := `{"formulas": [{"formula": "(A1 <=> B1) & (A2 <=> B2) & (A3 <=> B3)"}], "order": ["A1", "A2", "A3"]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("bdd/compilation?output=bdd"), input)
+	assert.Nil(err)
+	var explicit sio.BDDResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&explicit))
+	assert.Equal([]string{"A1", "A2", "A3", "B1", "B2", "B3"}, explicit.BDD.Order)
+
+	for _, size := range []string{"5", "100"} {
+		ep := endpoint("bdd/compilation?output=bdd&reordering=sift&nodeTableSize=" + size)
+		response, err := callServiceJSON(ctx, http.MethodPost, ep, input)
+		assert.Nil(err)
+		var result sio.BDDResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		assert.ElementsMatch(explicit.BDD.Order, result.BDD.Order)
+		assert.LessOrEqual(len(result.BDD.Nodes), len(explicit.BDD.Nodes))
+	}
+}
+
+func TestBDDModelCountInputVariables(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	for _, input := range []string{
+		`{"formulas": [{"formula": "A + B + C + D + E + F <= 2"}]}`,
+		`{"formulas": [{"formula": "A + B + C + D + E + F <= 2"}], "variables": ["X", "Y"]}`,
+		`{"formulas": [{"formula": "A + B + C + D + E + F <= 2"}], "operand": {"formulas": [{"formula": "X | Y"}]}}`,
+	} {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("bdd/query/model-count"), input)
+		assert.Nil(err)
+		assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "value": "22"
+}
+`, extractJSONBody(response))
+	}
+}
@@ -1,9 +1,13 @@
 package test
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/booleworks/logicng-service/sio"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,3 +20,118 @@ func TestDNNFCompilation(t *testing.T) {
 	assert.Nil(err)
 	validateJSONFormulaResult(t, response, "C | ~C & (D & A & B | ~D)")
 }
+
+func TestDNNFCompilationNNF(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	input := jsonFormulaInput("(A | B) & (A | C)")
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("dnnf/compilation?output=nnf"), input)
+	assert.Nil(err)
+	validateSuccess(t, response, "text/plain")
+	nnf := extractJSONBody(response)
+	assert.Equal(`c 1 A
+c 2 B
+c 3 C
+nnf 6 5 3
+L 1
+L -1
+L 3
+L 2
+A 3 1 2 3
+O 0 2 0 4
+`, nnf)
+
+	response, err = callServiceNNF(ctx, http.MethodPost, endpoint("model/counting"), nnf)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "value": "5"
+}
+`, extractJSONBody(response))
+}
+
+func TestDNNFCompilationGraph(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	input := jsonFormulaInput("(A | B) & (A | C)")
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("dnnf/compilation?output=graph"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "nodes": [
+    {
+      "id": 0,
+      "label": "A"
+    },
+    {
+      "id": 1,
+      "label": "~A"
+    },
+    {
+      "id": 2,
+      "label": "C"
+    },
+    {
+      "id": 3,
+      "label": "B"
+    },
+    {
+      "id": 4,
+      "label": "and"
+    },
+    {
+      "id": 5,
+      "label": "or"
+    }
+  ],
+  "edges": [
+    {
+      "srcID": 4,
+      "destID": 1
+    },
+    {
+      "srcID": 4,
+      "destID": 2
+    },
+    {
+      "srcID": 4,
+      "destID": 3
+    },
+    {
+      "srcID": 5,
+      "destID": 0
+    },
+    {
+      "srcID": 5,
+      "destID": 4
+    }
+  ]
+}
+`, extractJSONBody(response))
+}
+
+func TestNNFSharedNodes(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	// each layer is an or of two ands which share the previous layer
+	layers := 100
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("nnf %d %d %d\nL 1\n", 5*layers+1, 5*layers, layers+1))
+	prev := 0
+	for i := 0; i < layers; i++ {
+		id := 5*i + 1
+		sb.WriteString(fmt.Sprintf("L %d\nL -%d\n", i+2, i+2))
+		sb.WriteString(fmt.Sprintf("A 2 %d %d\nA 2 %d %d\n", prev, id, prev, id+1))
+		sb.WriteString(fmt.Sprintf("O %d 2 %d %d\n", i+2, id+2, id+3))
+		prev = id + 4
+	}
+	response, err := callServiceNNF(ctx, http.MethodPost, endpoint("formula/variables"), sb.String())
+	assert.Nil(err)
+	var result sio.StringSetResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.Equal(layers+1, len(result.Values))
+}
@@ -57,3 +57,69 @@ func TestEncodingPBC(t *testing.T) {
 		"(A | ~B | @RESERVED_PBC_0) & (~A | B | @RESERVED_PBC_0) & (~A | ~@RESERVED_PBC_1) & (~B | ~@RESERVED_PBC_1) "+
 		"& (A | B | @RESERVED_PBC_1) & ~@RESERVED_PBC_1")
 }
+
+func TestEncodingPBCOPB(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	ep := endpoint("encoding/pbc")
+	input := `
+* #variable= 2 #constraint= 1
++2 A +3 ~B >= 2 ;
+`
+	response, err := callServiceOPB(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "(A | @RESERVED_PBC_0) & (A | @RESERVED_PBC_1) & (~@RESERVED_PBC_0 | "+
+		"@RESERVED_PBC_3) & (~B | @RESERVED_PBC_3) & (~@RESERVED_PBC_1 | @RESERVED_PBC_4) & (~B | @RESERVED_PBC_4) & "+
+		"(~@RESERVED_PBC_2 | @RESERVED_PBC_5) & (~B | @RESERVED_PBC_5) & (~@RESERVED_PBC_0 | ~B)")
+}
+
+func TestEncodingCCClauses(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	ep := endpoint("encoding/cc?output=int-clauses")
+	input := `{"formulas": [{"formula": "A + B + C <= 1", "description": "amo"}, {"formula": "C + D >= 1"}]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body := extractJSONBody(response)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "variables": [
+    "A",
+    "B",
+    "C",
+    "D"
+  ],
+  "formulas": [
+    {
+      "description": "amo",
+      "intClauses": [
+        [
+          -1,
+          -2
+        ],
+        [
+          -1,
+          -3
+        ],
+        [
+          -2,
+          -3
+        ]
+      ]
+    },
+    {
+      "intClauses": [
+        [
+          3,
+          4
+        ]
+      ]
+    }
+  ]
+}
+`, body)
+}
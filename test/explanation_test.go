@@ -1,9 +1,11 @@
 package test
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
+	"github.com/booleworks/logicng-service/sio"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,3 +45,33 @@ func TestMUS(t *testing.T) {
 `
 	assert.Equal(expected, body)
 }
+
+func TestRedundancy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	input := `
+    {
+      "formulas": [
+	    {"formula": "A => B", "description": "r1"},
+	    {"formula": "B => C", "description": "r2"},
+	    {"formula": "A => C", "description": "r3"},
+	    {"formula": "C => A", "description": "r4"},
+	    {"formula": "A <=> C", "description": "r5"}
+      ]
+    }
+	`
+	redundancy := func(mode string) []string {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("explanation/redundancy?mode="+mode), input)
+		assert.Nil(err)
+		var result sio.FormulaResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		descriptions := make([]string, len(result.Formulas))
+		for i, f := range result.Formulas {
+			descriptions[i] = f.Description
+		}
+		return descriptions
+	}
+
+	assert.Equal([]string{"r3", "r4", "r5"}, redundancy("redundant"))
+	assert.Equal([]string{"r1", "r2", "r5"}, redundancy("irredundant"))
+}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const exportInput = `{"formulas": [
+  {"formula": "A & ~(B | C) => D <=> $true", "description": "rule 1"},
+  {"formula": "2*A + -3*~B + C <= 1"},
+  {"formula": "x_1 + B + C = 1"}
+]}`
+
+func TestExportSMTLib2(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("formula/export/smtlib2"), exportInput)
+	assert.Nil(err)
+	validateSuccess(t, response, "text/plain")
+	assert.Equal(`(set-logic QF_LIA)
+(declare-const A Bool)
+(declare-const B Bool)
+(declare-const C Bool)
+(declare-const D Bool)
+(declare-const x_1 Bool)
+(assert (! (=> (and A (not (or B C))) D) :named |rule 1|))
+(assert (<= (+ (* 2 (ite A 1 0)) (* (- 3) (ite B 0 1)) (ite C 1 0)) 1))
+(assert (= (+ (ite x_1 1 0) (ite B 1 0) (ite C 1 0)) 1))
+`, extractJSONBody(response))
+}
+
+func TestExportTPTP(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := `{"formulas": [{"formula": "a & ~(B | c) => d", "description": "rule1"}, {"formula": "a + b + c <= 1"}]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("formula/export/tptp"), input)
+	assert.Nil(err)
+	validateSuccess(t, response, "text/plain")
+	assert.Equal(`fof(rule1, axiom, (a & ~ ('B' | c)) => d).
+fof(f2, axiom, (a | ~ c | ~ b) & (~ a | ~ c | b) & (~ a | ~ b)).
+`, extractJSONBody(response))
+}
+
+func TestExportLaTeX(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("formula/export/latex"), exportInput)
+	assert.Nil(err)
+	validateSuccess(t, response, "text/plain")
+	assert.Equal(`A \land \lnot (B \lor C) \rightarrow D
+2 \cdot A + -3 \cdot \lnot B + C \leq 1
+\mathit{x\_1} + B + C = 1
+`, extractJSONBody(response))
+}
+
+func TestExportUnicode(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("formula/export/unicode"), exportInput)
+	assert.Nil(err)
+	validateSuccess(t, response, "text/plain")
+	assert.Equal(`A ∧ ¬(B ∨ C) → D
+2·A + -3·¬B + C ≤ 1
+x_1 + B + C = 1
+`, extractJSONBody(response))
+}
@@ -47,3 +47,32 @@ func TestMaxSatSolver(t *testing.T) {
 	body := extractJSONBody(response)
 	assert.Equal(expected, body)
 }
+
+func TestMaxSatSolverOPB(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	ep := endpoint("solver/maxsat")
+	input := `
+* #variable= 4 #constraint= 2
+min: +2 x1 -3 x2 +4 ~x3 +1 x4 ;
++1 x1 +1 x2 >= 1 ;
++1 ~x2 +1 x3 +1 x4 >= 2 ;
+`
+	response, err := callServiceOPB(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body := extractJSONBody(response)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "satisfiable": true,
+  "optimum": -2,
+  "model": [
+    "~x1",
+    "x2",
+    "x3",
+    "x4"
+  ]
+}
+`, body)
+}
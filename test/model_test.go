@@ -1,9 +1,16 @@
 package test
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
 	"net/http"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/booleworks/logicng-service/sio"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -109,3 +116,560 @@ func TestProjectedModelCount(t *testing.T) {
 `
 	assert.Equal(expected, body)
 }
+
+func TestProjectedModelCountAlgorithms(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	cases := []struct {
+		formulas  []string
+		variables []string
+		count     string
+	}{
+		{[]string{"~(A & B) => C | ~D", "~A | E"}, []string{"A", "C", "E"}, "6"},
+		{[]string{"(A <=> B) & (B <=> C)"}, []string{"A", "D"}, "4"},
+		{[]string{"A1 + A2 + A3 + A4 <= 2", "B | A1"}, []string{"A1", "A2", "B"}, "6"},
+		{[]string{"(A | B) & (C | D) & (~A | ~C)"}, []string{"A", "C"}, "3"},
+		{[]string{"A & ~A"}, []string{"A"}, "0"},
+	}
+	for _, c := range cases {
+		input := sio.FormulaVarsInput{Variables: c.variables}
+		for _, f := range c.formulas {
+			input.Formulas = append(input.Formulas, sio.Formula{Formula: f})
+		}
+		body, _ := json.Marshal(input)
+		for _, algorithm := range []string{"bdd", "dnnf", "sat"} {
+			ep := endpoint("model/counting/projection?algorithm=" + algorithm)
+			response, err := callServiceJSON(ctx, http.MethodPost, ep, string(body))
+			assert.Nil(err)
+			var result sio.StringResult
+			assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+			assert.Equal(c.count, result.Value, "%v with %s", c.formulas, algorithm)
+		}
+	}
+
+	// projections to many variables are infeasible for the enumeration-based counting
+	input := sio.FormulaVarsInput{}
+	for i := 1; i <= 40; i++ {
+		f := fmt.Sprintf("(A%d | B%d) & (B%d => C%d)", i, i, i, i)
+		input.Formulas = append(input.Formulas, sio.Formula{Formula: f})
+		input.Variables = append(input.Variables, fmt.Sprintf("A%d", i), fmt.Sprintf("C%d", i))
+	}
+	body, _ := json.Marshal(input)
+	for _, algorithm := range []string{"bdd", "dnnf"} {
+		ep := endpoint("model/counting/projection?algorithm=" + algorithm)
+		response, err := callServiceJSON(ctx, http.MethodPost, ep, string(body))
+		assert.Nil(err)
+		var result sio.StringResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		assert.Equal("12157665459056928801", result.Value, algorithm)
+	}
+}
+
+func TestWeightedModelCount(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	cases := []struct {
+		formulas []string
+		weights  map[string]string
+		value    string
+		approx   float64
+	}{
+		{[]string{"A | B"}, map[string]string{"A": "1/3", "~A": "2/3", "B": "0.25", "~B": "0.75"}, "1/2", 0.5},
+		{[]string{"A => B"}, map[string]string{"A": "2"}, "4", 4},
+		{[]string{"A + B + C = 1"}, map[string]string{"A": "1/2", "~A": "1/2", "B": "1/2", "~B": "1/2", "C": "1/2", "~C": "1/2"}, "3/8", 0.375},
+		{[]string{"(A | B) & (B | ~C)", "D"}, map[string]string{"A": "0.1", "~A": "0.9", "~C": "0", "D": "1/10"}, "1/10", 0.1},
+		{[]string{"A & ~A"}, map[string]string{"A": "1/2"}, "0", 0},
+	}
+	for _, c := range cases {
+		input := sio.WeightedCountInput{Weights: c.weights}
+		for _, f := range c.formulas {
+			input.Formulas = append(input.Formulas, sio.Formula{Formula: f})
+		}
+		body, _ := json.Marshal(input)
+		for _, algorithm := range []string{"bdd", "dnnf"} {
+			ep := endpoint("model/counting/weighted?algorithm=" + algorithm)
+			response, err := callServiceJSON(ctx, http.MethodPost, ep, string(body))
+			assert.Nil(err)
+			var result sio.WeightedCountResult
+			assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+			assert.Equal(c.value, result.Value, "%v with %s", c.formulas, algorithm)
+			assert.InDelta(c.approx, result.Approximation, 1e-9)
+		}
+	}
+}
+
+func TestMarginals(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("model/marginals"), input)
+	assert.Nil(err)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "count": "4",
+  "marginals": [
+    {
+      "variable": "A",
+      "count": "3",
+      "ratio": 0.75
+    },
+    {
+      "variable": "B",
+      "count": "2",
+      "ratio": 0.5
+    },
+    {
+      "variable": "C",
+      "count": "3",
+      "ratio": 0.75
+    }
+  ]
+}
+`, extractJSONBody(response))
+
+	input = `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}], "projection": ["A", "C"]}`
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/marginals"), input)
+	assert.Nil(err)
+	var result sio.MarginalsResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.Equal("3", result.Count)
+	assert.Equal([]sio.Marginal{{Variable: "A", Count: "2", Ratio: 2.0 / 3}, {Variable: "C", Count: "2", Ratio: 2.0 / 3}}, result.Marginals)
+
+	input = `{"formulas": [{"formula": "A | B"}], "variables": ["D", "A"]}`
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/marginals"), input)
+	assert.Nil(err)
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.Equal("6", result.Count)
+	assert.Equal([]sio.Marginal{{Variable: "D", Count: "3", Ratio: 0.5}, {Variable: "A", Count: "4", Ratio: 2.0 / 3}}, result.Marginals)
+}
+
+func TestModelSampling(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	sample := func(path, input string) []string {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint(path), input)
+		assert.Nil(err)
+		var result sio.FormulaResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		models := make([]string, len(result.Formulas))
+		for i, f := range result.Formulas {
+			models[i] = f.Formula
+		}
+		return models
+	}
+	frequencies := func(models []string) map[string]int {
+		result := make(map[string]int)
+		for _, m := range models {
+			result[m]++
+		}
+		return result
+	}
+
+	input := `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}]}`
+	models := sample("model/sampling?n=4000&seed=42", input)
+	assert.Len(models, 4000)
+	counts := frequencies(models)
+	assert.Len(counts, 4)
+	for _, count := range counts {
+		assert.InDelta(1000, count, 150)
+	}
+	assert.Equal(models, sample("model/sampling?n=4000&seed=42", input))
+	assert.NotEqual(models, sample("model/sampling?n=4000&seed=7", input))
+
+	input = `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}], "projection": ["A", "C"]}`
+	counts = frequencies(sample("model/sampling?n=3000&seed=42", input))
+	assert.Len(counts, 3)
+	assert.NotContains(counts, "~A & ~C")
+	for _, count := range counts {
+		assert.InDelta(1000, count, 150)
+	}
+
+	input = `{"formulas": [{"formula": "A + B + C + D <= 1"}], "projection": ["A", "B", "C", "D", "E"]}`
+	counts = frequencies(sample("model/sampling?n=5000&seed=42", input))
+	assert.Len(counts, 10)
+	for _, count := range counts {
+		assert.InDelta(500, count, 100)
+	}
+
+	input = `{"formulas": [{"formula": "A & ~A"}]}`
+	assert.Empty(sample("model/sampling?n=10", input))
+
+	input = `{"formulas": [{"formula": "A | B"}], "weights": {"A": "0", "~B": "5"}}`
+	assert.Equal([]string{"~A & B", "~A & B", "~A & B"}, sample("model/sampling/weighted?n=3", input))
+
+	input = `{"formulas": [{"formula": "A | B"}], "weights": {"A": "3", "~A": "1/2", "B": "0.5"}}`
+	counts = frequencies(sample("model/sampling/weighted?n=4000&seed=42", input))
+	assert.InDelta(4000*1.5/4.75, counts["A & B"], 100)
+	assert.InDelta(4000*3.0/4.75, counts["A & ~B"], 100)
+	assert.InDelta(4000*0.25/4.75, counts["~A & B"], 50)
+}
+
+func TestApproxModelCount(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	approxCount := func(path, input string) sio.ApproxCountResult {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint(path), input)
+		assert.Nil(err)
+		var result sio.ApproxCountResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		return result
+	}
+	assertBounds := func(exact int64, result sio.ApproxCountResult) {
+		lower, _ := new(big.Int).SetString(result.LowerBound, 10)
+		upper, _ := new(big.Int).SetString(result.UpperBound, 10)
+		assert.LessOrEqual(lower.Int64(), exact)
+		assert.GreaterOrEqual(upper.Int64(), exact)
+		assert.False(result.Exact)
+	}
+
+	input := `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}]}`
+	assert.Equal(sio.ApproxCountResult{
+		State:      sio.ComputationState{Success: true},
+		Value:      "4",
+		LowerBound: "4",
+		UpperBound: "4",
+		Confidence: 1,
+		Exact:      true,
+	}, approxCount("model/counting?algorithm=approx", input))
+
+	var vars []string
+	for i := 0; i < 20; i++ {
+		vars = append(vars, fmt.Sprintf("X%d", i))
+	}
+	input = fmt.Sprintf(`{"formulas": [{"formula": "%s"}]}`, strings.Join(vars[:16], " | "))
+	result := approxCount("model/counting?algorithm=approx&seed=42", input)
+	assertBounds(65535, result)
+	assert.Equal(0.8, result.Confidence)
+	assert.Equal(result, approxCount("model/counting?algorithm=approx&seed=42", input))
+	result = approxCount("model/counting?algorithm=approx&seed=42&epsilon=0.5&delta=0.1", input)
+	assertBounds(65535, result)
+	assert.Equal(0.9, result.Confidence)
+
+	input = fmt.Sprintf(`{"formulas": [{"formula": "%s <= 3"}], "variables": ["%s"]}`,
+		strings.Join(vars, " + "), strings.Join(vars[:12], `", "`))
+	assertBounds(299, approxCount("model/counting/projection?algorithm=approx&seed=42", input))
+}
+
+func TestPagedModelEnumeration(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	page := func(path, input string) sio.ModelPageResult {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint(path), input)
+		assert.Nil(err)
+		var result sio.ModelPageResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		return result
+	}
+	// enumerateAll fetches all pages and returns the models and the number of pages
+	enumerateAll := func(path, input string) ([]string, int) {
+		var models []string
+		pages := 0
+		cursor := ""
+		for {
+			result := page(path+"&cursor="+cursor, input)
+			pages++
+			for _, m := range result.Models {
+				models = append(models, m.Formula)
+			}
+			assert.Equal(result.Truncated, result.Cursor != "")
+			if !result.Truncated {
+				return models, pages
+			}
+			cursor = result.Cursor
+		}
+	}
+
+	input := `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}]}`
+	result := page("model/enumeration?limit=3", input)
+	assert.Len(result.Models, 3)
+	assert.True(result.Truncated)
+	assert.Equal("4", result.Count)
+	next := page("model/enumeration?limit=3&cursor="+result.Cursor, input)
+	assert.Len(next.Models, 1)
+	assert.False(next.Truncated)
+	assert.Empty(next.Cursor)
+	assert.Equal("4", next.Count)
+	expected := []string{"A & ~B & ~C", "A & ~B & C", "A & B & C", "~A & B & C"}
+	for _, algorithm := range []string{"bdd", "sat"} {
+		for _, limit := range []int{1, 2, 3, 4, 5} {
+			models, pages := enumerateAll(fmt.Sprintf("model/enumeration?algorithm=%s&limit=%d", algorithm, limit), input)
+			assert.ElementsMatch(expected, models)
+			assert.Equal((4+limit-1)/limit, pages)
+		}
+	}
+
+	models, _ := enumerateAll("model/enumeration?algorithm=sat&limit=1", input)
+	assert.Equal([]string{"~A & B & C", "A & ~B & ~C", "A & ~B & C", "A & B & C"}, models)
+
+	result = page("model/enumeration?algorithm=sat&limit=2", input)
+	assert.Len(result.Models, 2)
+	assert.True(result.Truncated)
+	assert.Empty(result.Count)
+
+	input = `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}], "variables": ["C", "A"]}`
+	for _, algorithm := range []string{"bdd", "sat"} {
+		models, pages := enumerateAll("model/enumeration/projection?limit=1&algorithm="+algorithm, input)
+		assert.ElementsMatch([]string{"A & ~C", "A & C", "~A & C"}, models)
+		assert.Equal(3, pages)
+	}
+
+	var vars []string
+	for i := 0; i < 10; i++ {
+		vars = append(vars, fmt.Sprintf("X%d", i))
+	}
+	input = fmt.Sprintf(`{"formulas": [{"formula": "%s"}]}`, strings.Join(vars, " | "))
+	for _, algorithm := range []string{"bdd", "sat"} {
+		models, pages := enumerateAll("model/enumeration?limit=100&algorithm="+algorithm, input)
+		assert.Len(models, 1023)
+		slices.Sort(models)
+		assert.Len(slices.Compact(models), 1023)
+		assert.Equal(11, pages)
+	}
+	// the SAT cursor holds only the last model and does not grow
+	first := page("model/enumeration?limit=100&algorithm=sat", input)
+	second := page("model/enumeration?limit=100&algorithm=sat&cursor="+first.Cursor, input)
+	assert.Len(second.Models, 100)
+	assert.Equal(len(first.Cursor), len(second.Cursor))
+
+	input = `{"formulas": [{"formula": "A & ~A"}]}`
+	result = page("model/enumeration?limit=10", input)
+	assert.Empty(result.Models)
+	assert.False(result.Truncated)
+	assert.Equal("0", result.Count)
+}
+
+func TestModelEnumerationCubes(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	cubes := func(path, input string) sio.CubeResult {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint(path), input)
+		assert.Nil(err)
+		var result sio.CubeResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		return result
+	}
+	// expand returns the models covered by the cubes, so duplicates reveal
+	// overlapping cubes
+	expand := func(result sio.CubeResult) []string {
+		var models []string
+		for _, cube := range result.Cubes {
+			assigned := make(map[string]string)
+			for _, lit := range cube.Literals {
+				assigned[strings.TrimPrefix(lit, "~")] = lit
+			}
+			partial := []string{""}
+			for _, v := range result.Variables {
+				var next []string
+				for _, prefix := range partial {
+					for _, lit := range []string{"~" + v, v} {
+						if assigned[v] == "" || assigned[v] == lit {
+							next = append(next, strings.TrimPrefix(prefix+" & "+lit, " & "))
+						}
+					}
+				}
+				partial = next
+			}
+			count, _ := new(big.Int).SetString(cube.Count, 10)
+			assert.Equal(int64(len(partial)), count.Int64())
+			models = append(models, partial...)
+		}
+		return models
+	}
+
+	input := `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}]}`
+	for _, algorithm := range []string{"bdd", "sat"} {
+		result := cubes("model/enumeration?output=cubes&algorithm="+algorithm, input)
+		assert.Equal([]string{"A", "B", "C"}, result.Variables)
+		assert.Less(len(result.Cubes), 4)
+		assert.ElementsMatch([]string{"A & ~B & ~C", "A & ~B & C", "A & B & C", "~A & B & C"}, expand(result))
+	}
+	assert.Equal(sio.CubeResult{
+		State:     sio.ComputationState{Success: true},
+		Variables: []string{"A", "B", "C"},
+		Cubes: []sio.Cube{
+			{Literals: []string{"B", "C"}, Count: "2"},
+			{Literals: []string{"A", "~B"}, Count: "2"},
+		},
+	}, cubes("model/enumeration?output=cubes&algorithm=sat", input))
+
+	input = `{"formulas": [{"formula": "A | B"}, {"formula": "B => C"}], "variables": ["C", "A", "D"]}`
+	for _, algorithm := range []string{"bdd", "sat"} {
+		result := cubes("model/enumeration/projection?output=cubes&algorithm="+algorithm, input)
+		assert.Equal([]string{"A", "C", "D"}, result.Variables)
+		assert.ElementsMatch([]string{"A & ~C & ~D", "A & ~C & D", "A & C & ~D", "A & C & D", "~A & C & ~D", "~A & C & D"},
+			expand(result))
+	}
+
+	var vars []string
+	for i := 0; i < 12; i++ {
+		vars = append(vars, fmt.Sprintf("X%d", i))
+	}
+	for _, f := range []string{strings.Join(vars, " | "), strings.Join(vars, " + ") + " <= 2"} {
+		input = fmt.Sprintf(`{"formulas": [{"formula": "%s"}]}`, f)
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("model/counting?algorithm=bdd"), input)
+		assert.Nil(err)
+		var count sio.StringResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&count))
+		for _, algorithm := range []string{"bdd", "sat"} {
+			models := expand(cubes("model/enumeration?output=cubes&algorithm="+algorithm, input))
+			assert.Equal(count.Value, fmt.Sprint(len(models)))
+			slices.Sort(models)
+			assert.Equal(count.Value, fmt.Sprint(len(slices.Compact(models))))
+		}
+	}
+
+	bin, _ := sio.FormulaInput{Formulas: []sio.Formula{{Formula: "(A | B) & (B => C)"}}}.ProtoBuf()
+	response, err := callServiceProtoBuf(ctx, http.MethodPost, endpoint("model/enumeration?output=cubes&algorithm=sat"), bin)
+	assert.Nil(err)
+	validateSuccess(t, response, "application/protobuf")
+	data, err := io.ReadAll(response.Body)
+	assert.Nil(err)
+	result, err := sio.CubeResult{}.DeserProtoBuf(data)
+	assert.Nil(err)
+	assert.Equal(cubes("model/enumeration?output=cubes&algorithm=sat", jsonFormulaInput("(A | B) & (B => C)")), result)
+}
+
+func TestProjectedModelEnumerationAdditionalVariables(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	// models returns the models with their literals sorted by variable
+	models := func(result []sio.Formula) []string {
+		var models []string
+		for _, m := range result {
+			literals := strings.Split(m.Formula, " & ")
+			slices.SortFunc(literals, func(a, b string) int {
+				return strings.Compare(strings.TrimPrefix(a, "~"), strings.TrimPrefix(b, "~"))
+			})
+			models = append(models, strings.Join(literals, " & "))
+		}
+		return models
+	}
+	input := `{"formulas": [{"formula": "(A | B) & (B => C)"}], "variables": ["A"], "additionalVariables": ["B", "C", "A", "D"]}`
+	completions := []string{"A & ~B & ~C & ~D", "A & ~B & C & ~D", "A & B & C & ~D"}
+	for _, algorithm := range []string{"bdd", "sat"} {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("model/enumeration/projection?algorithm="+algorithm), input)
+		assert.Nil(err)
+		var result sio.FormulaResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		enumeration := models(result.Formulas)
+		assert.Len(enumeration, 2)
+		assert.Contains(enumeration, "~A & B & C & ~D")
+		assert.True(slices.ContainsFunc(completions, func(m string) bool { return slices.Contains(enumeration, m) }))
+
+		var pages []string
+		cursor := ""
+		for {
+			path := fmt.Sprintf("model/enumeration/projection?algorithm=%s&limit=1&cursor=%s", algorithm, cursor)
+			response, err := callServiceJSON(ctx, http.MethodPost, endpoint(path), input)
+			assert.Nil(err)
+			var page sio.ModelPageResult
+			assert.Nil(json.NewDecoder(response.Body).Decode(&page))
+			pages = append(pages, models(page.Models)...)
+			if !page.Truncated {
+				break
+			}
+			cursor = page.Cursor
+		}
+		assert.Len(pages, 2)
+		assert.Contains(pages, "~A & B & C & ~D")
+		assert.True(slices.ContainsFunc(completions, func(m string) bool { return slices.Contains(pages, m) }))
+	}
+}
+
+func TestModelAssumptions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := `{"formulas": [{"formula": "(A | B) & (B => C)"}], "assumptions": ["B"]}`
+	for _, algorithm := range []string{"dnnf", "bdd", "sat"} {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("model/counting?algorithm="+algorithm), input)
+		assert.Nil(err)
+		var result sio.StringResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		assert.Equal("2", result.Value)
+	}
+
+	input = `{"formulas": [{"formula": "(A | B) & (B => C)"}], "variables": ["A", "B"], "assumptions": ["~C"]}`
+	for _, algorithm := range []string{"dnnf", "bdd", "sat"} {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("model/counting/projection?algorithm="+algorithm), input)
+		assert.Nil(err)
+		var result sio.StringResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		assert.Equal("1", result.Value)
+	}
+
+	input = `{"formulas": [{"formula": "(A | B) & (B => C)"}], "assumptions": ["~A"]}`
+	for _, algorithm := range []string{"bdd", "sat"} {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("model/enumeration?algorithm="+algorithm), input)
+		assert.Nil(err)
+		var result sio.FormulaResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		assert.Len(result.Formulas, 1)
+		assert.Equal("~A & B & C", result.Formulas[0].Formula)
+	}
+}
+
+func TestModelFailedAssumptions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := `{"formulas": [{"formula": "(A | B) & (B => C)"}], "assumptions": ["D", "~A", "~C"]}`
+	for _, algorithm := range []string{"dnnf", "bdd", "sat"} {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("model/counting?algorithm="+algorithm), input)
+		assert.Nil(err)
+		var result sio.StringResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		assert.Equal("0", result.Value)
+		assert.Equal([]string{"~A", "~C"}, result.FailedAssumptions)
+	}
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("model/counting?algorithm=approx"), input)
+	assert.Nil(err)
+	var approx sio.ApproxCountResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&approx))
+	assert.Equal("0", approx.Value)
+	assert.True(approx.Exact)
+	assert.Equal([]string{"~A", "~C"}, approx.FailedAssumptions)
+
+	projected := `{"formulas": [{"formula": "(A | B) & (B => C)"}], "variables": ["A"], "assumptions": ["~A", "~C"]}`
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/counting/projection"), projected)
+	assert.Nil(err)
+	var count sio.StringResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&count))
+	assert.Equal("0", count.Value)
+	assert.Equal([]string{"~A", "~C"}, count.FailedAssumptions)
+
+	for _, algorithm := range []string{"bdd", "sat"} {
+		response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/enumeration?algorithm="+algorithm), input)
+		assert.Nil(err)
+		var result sio.FormulaResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		assert.Empty(result.Formulas)
+		assert.Equal([]string{"~A", "~C"}, result.FailedAssumptions)
+	}
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/enumeration/projection?limit=1"), projected)
+	assert.Nil(err)
+	var page sio.ModelPageResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&page))
+	assert.Empty(page.Models)
+	assert.False(page.Truncated)
+	assert.Equal([]string{"~A", "~C"}, page.FailedAssumptions)
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/enumeration?output=cubes"), input)
+	assert.Nil(err)
+	var cubes sio.CubeResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&cubes))
+	assert.Equal([]string{"A", "B", "C", "D"}, cubes.Variables)
+	assert.Empty(cubes.Cubes)
+	assert.Equal([]string{"~A", "~C"}, cubes.FailedAssumptions)
+}
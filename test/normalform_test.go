@@ -1,9 +1,14 @@
 package test
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/booleworks/logicng-service/sio"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -120,3 +125,172 @@ func TestNFTransProtoBuf(t *testing.T) {
 	assert.Nil(err)
 	validateProtoBufFormulaResult(t, response, "(A & B & C | ~D) & (~A | ~B | ~C | D)")
 }
+
+func TestNFTransCNFClauses(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	ep := endpoint("normalform/transformation/cnf?output=clauses")
+	input := jsonFormulaInput("~(A & B => ~C <=> D)")
+	response, err := callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body := extractJSONBody(response)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "formulas": [
+    {
+      "clauses": [
+        [
+          "A",
+          "~D"
+        ],
+        [
+          "B",
+          "~D"
+        ],
+        [
+          "C",
+          "~D"
+        ],
+        [
+          "~A",
+          "~B",
+          "~C",
+          "D"
+        ]
+      ]
+    }
+  ]
+}
+`, body)
+
+	ep = endpoint("normalform/transformation/cnf?algorithm=bdd&output=int-clauses")
+	response, err = callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body = extractJSONBody(response)
+	assert.Equal(`{
+  "state": {
+    "success": true
+  },
+  "variables": [
+    "A",
+    "D",
+    "B",
+    "C"
+  ],
+  "formulas": [
+    {
+      "intClauses": [
+        [
+          1,
+          -2
+        ],
+        [
+          -1,
+          2,
+          -3,
+          -4
+        ],
+        [
+          -1,
+          -2,
+          3
+        ],
+        [
+          -1,
+          -2,
+          -3,
+          4
+        ]
+      ]
+    }
+  ]
+}
+`, body)
+}
+
+func TestNFTransDNFClausesProtoBuf(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	ep := endpoint("normalform/transformation/dnf?output=int-clauses")
+	response, err := callServiceProtoBuf(ctx, http.MethodPost, ep, pbFormulaInput("(A | B) & ~C"))
+	assert.Nil(err)
+	validateSuccess(t, response, "application/protobuf")
+	data, err := io.ReadAll(response.Body)
+	assert.Nil(err)
+	result, err := sio.ClauseResult{}.DeserProtoBuf(data)
+	assert.Nil(err)
+	assert.True(result.State.Success)
+	assert.Equal([]string{"A", "C", "B"}, result.Variables)
+	assert.Equal([][]int32{{1, -2}, {3, -2}}, result.Formulas[0].IntClauses)
+}
+
+func TestNFTransAIGER(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	input := `{"formulas": [{"formula": "A & B & ~C", "description": "out1"}, {"formula": "~(A & B) | C"}]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("normalform/transformation/aig?output=aag"), input)
+	assert.Nil(err)
+	validateSuccess(t, response, "text/plain")
+	assert.Equal(`aag 5 3 0 2 2
+2
+4
+6
+10
+11
+8 4 2
+10 8 7
+i0 A
+i1 B
+i2 C
+o0 out1
+`, extractJSONBody(response))
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("normalform/transformation/aig?output=aig"), input)
+	assert.Nil(err)
+	validateSuccess(t, response, "application/aiger")
+	aig, err := io.ReadAll(response.Body)
+	assert.Nil(err)
+	assert.Equal("aig 5 3 0 2 2\n10\n11\n\x04\x02\x02\x01i0 A\ni1 B\ni2 C\no0 out1\n", string(aig))
+
+	response, err = callServiceAIGER(ctx, http.MethodPost, endpoint("normalform/transformation/nnf"), aig)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "B & A & ~C & (~B | ~A | C)")
+
+	aag := []byte("aag 3 2 0 1 1\n2\n4\n7\n6 2 5\ni0 x\ni1 y\no0 nand\nc\ncomment\n")
+	response, err = callServiceAIGER(ctx, http.MethodPost, endpoint("normalform/transformation/aig"), aag)
+	assert.Nil(err)
+	validateJSONFormulaResult(t, response, "~(x & ~y)")
+}
+
+func TestAIGERSharedGates(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	// each layer is an or of two and gates which share the previous layer
+	layers := 100
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("aag %d %d 0 1 %d\n", 4*layers+1, layers+1, 3*layers))
+	for i := 0; i <= layers; i++ {
+		sb.WriteString(fmt.Sprintf("%d\n", 2*(i+1)))
+	}
+	var gates strings.Builder
+	prev, v := 2, layers+2
+	for k := 1; k <= layers; k++ {
+		input := 2 * (k + 1)
+		gates.WriteString(fmt.Sprintf("%d %d %d\n", 2*v, prev, input))
+		gates.WriteString(fmt.Sprintf("%d %d %d\n", 2*(v+1), prev, input+1))
+		gates.WriteString(fmt.Sprintf("%d %d %d\n", 2*(v+2), 2*v+1, 2*(v+1)+1))
+		prev, v = 2*(v+2)+1, v+3
+	}
+	sb.WriteString(fmt.Sprintf("%d\n", prev))
+	sb.WriteString(gates.String())
+	response, err := callServiceAIGER(ctx, http.MethodPost, endpoint("formula/variables"), []byte(sb.String()))
+	assert.Nil(err)
+	var result sio.StringSetResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.Equal(layers+1, len(result.Values))
+}
@@ -1,9 +1,14 @@
 package test
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/booleworks/logicng-service/sio"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -255,3 +260,348 @@ func TestEquivalence(t *testing.T) {
 	assert.Nil(err)
 	validateJSONBoolResult(t, response, true)
 }
+
+func TestSatOPB(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	ep := endpoint("solver/sat")
+	input := `
+* #variable= 3 #constraint= 3
++1 x1 +1 x2 +1 x3 >= 2 ;
++2 x1 -1 ~x2 = 2 ;
++1 ~x3 >= 1 ;
+`
+	response, err := callServiceOPB(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body := extractJSONBody(response)
+	expected := `{
+  "state": {
+    "success": true
+  },
+  "satisfiable": true,
+  "model": [
+    "x1",
+    "x2",
+    "~x3"
+  ]
+}
+`
+	assert.Equal(expected, body)
+}
+
+func TestSatSMT2(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	ep := endpoint("solver/sat?core=true")
+	input := `
+; QF_BOOL benchmark
+(set-logic QF_BOOL)
+(declare-const a Bool)
+(declare-const b Bool)
+(declare-fun |c| () Bool)
+(define-fun ab () Bool (xor a b))
+(assert (! (ite a b |c|) :named first))
+(assert (! (let ((x (not a))) (and x ab)) :named second))
+(assert (! (=> b |c| false) :named third))
+(assert (or a (distinct b |c|)))
+(check-sat)
+(exit)
+`
+	response, err := callServiceSMT2(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body := extractJSONBody(response)
+	expected := `{
+  "state": {
+    "success": true
+  },
+  "satisfiable": false,
+  "unsatCore": [
+    {
+      "formula": "(a => b) & (~a => c)",
+      "description": "first"
+    },
+    {
+      "formula": "~a & ~(a <=> b)",
+      "description": "second"
+    },
+    {
+      "formula": "b => ~c",
+      "description": "third"
+    }
+  ]
+}
+`
+	assert.Equal(expected, body)
+}
+
+func TestSMT2SharedDefinitions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	// each definition refers twice to the previous one
+	definitions := 100
+	var sb strings.Builder
+	sb.WriteString("(declare-const x0 Bool)\n(define-fun d0 () Bool x0)\n")
+	for i := 1; i <= definitions; i++ {
+		sb.WriteString(fmt.Sprintf("(declare-const x%d Bool)\n", i))
+		sb.WriteString(fmt.Sprintf("(define-fun d%d () Bool (ite x%d d%d (not d%d)))\n", i, i, i-1, i-1))
+	}
+	sb.WriteString(fmt.Sprintf("(assert d%d)\n", definitions))
+	response, err := callServiceSMT2(ctx, http.MethodPost, endpoint("formula/variables"), sb.String())
+	assert.Nil(err)
+	var result sio.StringSetResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.Equal(definitions+1, len(result.Values))
+}
+
+func TestSatAssumptions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	ep := endpoint("solver/sat")
+	input := `
+    {
+      "formulas": [
+	    {"formula": "A => B"},
+	    {"formula": "B => C"},
+	    {"formula": "~C | ~D"}
+      ],
+      "assumptions": ["A", "~E"]
+    }
+	`
+	response, err := callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body := extractJSONBody(response)
+	expected := `{
+  "state": {
+    "success": true
+  },
+  "satisfiable": true,
+  "model": [
+    "A",
+    "B",
+    "C",
+    "~D",
+    "~E"
+  ]
+}
+`
+	assert.Equal(expected, body)
+
+	input = `
+    {
+      "formulas": [
+	    {"formula": "A => B"},
+	    {"formula": "B => C"},
+	    {"formula": "~C | ~D"}
+      ],
+      "assumptions": ["E", "A", "F", "D"]
+    }
+	`
+	response, err = callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body = extractJSONBody(response)
+	expected = `{
+  "state": {
+    "success": true
+  },
+  "satisfiable": false,
+  "failedAssumptions": [
+    "A",
+    "D"
+  ]
+}
+`
+	assert.Equal(expected, body)
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("solver/sat?core=true"), input)
+	assert.Nil(err)
+	var result sio.SatResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.False(result.Satisfiable)
+	assert.Equal([]string{"A", "D"}, result.FailedAssumptions)
+	core := make([]string, len(result.UnsatCore))
+	for i, f := range result.UnsatCore {
+		core[i] = f.Formula
+	}
+	assert.ElementsMatch([]string{"A => B", "B => C", "~C | ~D"}, core)
+
+	ep = endpoint("solver/backbone")
+	response, err = callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body = extractJSONBody(response)
+	expected = `{
+  "state": {
+    "success": true
+  },
+  "satisfiable": false,
+  "failedAssumptions": [
+    "A",
+    "D"
+  ]
+}
+`
+	assert.Equal(expected, body)
+
+	input = `
+    {
+      "formulas": [
+	    {"formula": "A => B"},
+	    {"formula": "B => C"},
+	    {"formula": "~C | ~D"}
+      ],
+      "assumptions": ["A"]
+    }
+	`
+	response, err = callServiceJSON(ctx, http.MethodPost, ep, input)
+	assert.Nil(err)
+	body = extractJSONBody(response)
+	expected = `{
+  "state": {
+    "success": true
+  },
+  "satisfiable": true,
+  "positive": [
+    "A",
+    "B",
+    "C"
+  ],
+  "negative": [
+    "D"
+  ]
+}
+`
+	assert.Equal(expected, body)
+}
+
+func TestSatSolverConfig(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	formulas := []sio.Formula{{Formula: "A | B"}, {Formula: "B => C | D"}, {Formula: "~C | ~D"}}
+	satisfiable := func(response *http.Response) sio.SatResult {
+		validateSuccess(t, response, "application/protobuf")
+		data, err := io.ReadAll(response.Body)
+		assert.Nil(err)
+		result, err := sio.SatResult{}.DeserProtoBuf(data)
+		assert.Nil(err)
+		return result
+	}
+
+	input := sio.FormulaInput{Formulas: formulas, SolverConfig: &sio.SolverConfig{InitialPhase: true}}
+	bin, _ := input.ProtoBuf()
+	response, err := callServiceProtoBuf(ctx, http.MethodPost, endpoint("solver/sat"), bin)
+	assert.Nil(err)
+	result := satisfiable(response)
+	assert.True(result.Satisfiable)
+	assert.Contains(result.Model, "A")
+	assert.Contains(result.Model, "B")
+	positive := result.Model
+
+	input.SolverConfig.InitialPhase = false
+	bin, _ = input.ProtoBuf()
+	response, err = callServiceProtoBuf(ctx, http.MethodPost, endpoint("solver/sat"), bin)
+	assert.Nil(err)
+	result = satisfiable(response)
+	assert.True(result.Satisfiable)
+	assert.NotEqual(positive, result.Model)
+
+	configs := []string{
+		`{"clauseMinimization": "none", "cnfMethod": "factorization"}`,
+		`{"clauseMinimization": "basic", "cnfMethod": "full-pg", "restartFactor": 0.5, "restartQueueSize": 10}`,
+		`{"varDecay": 0.8, "clauseDecay": 0.9, "blockingFactor": 2, "blockingQueueSize": 100}`,
+		`{"firstReduceDB": 10, "incReduceDB": 10, "frozenLBD": 5, "minimizationLBD": 2, "minimizationSize": 5}`,
+	}
+	for _, cfg := range configs {
+		input := fmt.Sprintf(`{"formulas": [{"formula": "A | B"}, {"formula": "~A | ~B"}, {"formula": "A <=> B | C"}], "solverConfig": %s}`, cfg)
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("solver/predicate/contradiction"), input)
+		assert.Nil(err)
+		var contradiction sio.BoolResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&contradiction))
+		assert.False(contradiction.Value)
+
+		response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/counting?algorithm=sat"), input)
+		assert.Nil(err)
+		var count sio.StringResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&count))
+		assert.Equal("1", count.Value)
+
+		response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/counting/weighted?algorithm=dnnf"), input)
+		assert.Nil(err)
+		var weighted sio.WeightedCountResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&weighted))
+		assert.Equal("1", weighted.Value)
+
+		response, err = callServiceJSON(ctx, http.MethodPost, endpoint("model/marginals"), input)
+		assert.Nil(err)
+		var marginals sio.MarginalsResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&marginals))
+		assert.Equal("1", marginals.Count)
+
+		response, err = callServiceJSON(ctx, http.MethodPost, endpoint("explanation/mus"), strings.Replace(input, `"formulas": [`, `"formulas": [{"formula": "~C"}, `, 1))
+		assert.Nil(err)
+		var mus sio.FormulaResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&mus))
+		assert.Len(mus.Formulas, 4)
+	}
+}
+
+func TestPredicateCounterexamples(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	predicate := func(path, input string) sio.PredicateResult {
+		response, err := callServiceJSON(ctx, http.MethodPost, endpoint("solver/predicate/"+path), input)
+		assert.Nil(err)
+		var result sio.PredicateResult
+		assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+		return result
+	}
+
+	result := predicate("tautology", `{"formulas": [{"formula": "A | ~B"}]}`)
+	assert.False(result.Value)
+	assert.Equal([]string{"~A", "B"}, result.Counterexample)
+	assert.Empty(result.TrueSide)
+
+	result = predicate("tautology", `{"formulas": [{"formula": "A | ~A"}]}`)
+	assert.True(result.Value)
+	assert.Empty(result.Counterexample)
+
+	result = predicate("contradiction", `{"formulas": [{"formula": "A & ~B"}]}`)
+	assert.False(result.Value)
+	assert.Equal([]string{"A", "~B"}, result.Counterexample)
+
+	result = predicate("implication", `{"formulas": [{"formula": "A & B"}, {"formula": "A & B & C"}]}`)
+	assert.False(result.Value)
+	assert.Equal([]string{"A", "B", "~C"}, result.Counterexample)
+
+	result = predicate("equivalence", `{"formulas": [{"formula": "A & B"}, {"formula": "A & B & C"}]}`)
+	assert.False(result.Value)
+	assert.Equal([]string{"A", "B", "~C"}, result.Counterexample)
+	assert.Equal("first", result.TrueSide)
+
+	result = predicate("equivalence", `{"formulas": [{"formula": "A & B & C"}, {"formula": "A & (B | C)"}]}`)
+	assert.False(result.Value)
+	assert.Contains(result.Counterexample, "A")
+	assert.Equal("second", result.TrueSide)
+
+	result = predicate("equivalence", `{"formulas": [{"formula": "A => C"}, {"formula": "~A | C"}]}`)
+	assert.True(result.Value)
+	assert.Empty(result.Counterexample)
+	assert.Empty(result.TrueSide)
+}
+
+func TestSatRelations(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+	input := `{"formulas": [{"formula": "A & B"}, {"formula": "A"}, {"formula": "A | C"}, {"formula": "B & A"}, {"formula": "~~A"}, {"formula": "D"}]}`
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("solver/relations"), input)
+	assert.Nil(err)
+	var result sio.GraphResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.True(result.State.Success)
+	assert.Equal(6, len(result.Nodes))
+	assert.Equal(sio.Node{ID: 5, Label: "D"}, result.Nodes[5])
+	assert.ElementsMatch([]sio.Edge{
+		{SrcID: 0, DestID: 3, Label: "<=>"},
+		{SrcID: 1, DestID: 4, Label: "<=>"},
+		{SrcID: 0, DestID: 1, Label: "=>"},
+		{SrcID: 1, DestID: 2, Label: "=>"},
+	}, result.Edges)
+}
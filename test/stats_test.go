@@ -0,0 +1,70 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/booleworks/logicng-service/sio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	assert := assert.New(t)
+	ctx := runServer(t)
+
+	// pigeon hole formula with four pigeons and three holes
+	var formulas []string
+	for p := 1; p <= 4; p++ {
+		formulas = append(formulas, fmt.Sprintf(`{"formula": "p%[1]dh1 | p%[1]dh2 | p%[1]dh3"}`, p))
+	}
+	for h := 1; h <= 3; h++ {
+		formulas = append(formulas, fmt.Sprintf(`{"formula": "p1h%[1]d + p2h%[1]d + p3h%[1]d + p4h%[1]d <= 1"}`, h))
+	}
+	input := fmt.Sprintf(`{"formulas": [%s]}`, strings.Join(formulas, ", "))
+
+	response, err := callServiceJSON(ctx, http.MethodPost, endpoint("solver/sat"), input)
+	assert.Nil(err)
+	var result sio.SatResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.Nil(result.State.Stats)
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("solver/sat?stats=true"), input)
+	assert.Nil(err)
+	assert.Nil(json.NewDecoder(response.Body).Decode(&result))
+	assert.False(result.Satisfiable)
+	stats := result.State.Stats
+	assert.NotNil(stats)
+	assert.Equal(12, stats.Variables)
+	assert.Equal(31, stats.Nodes)
+	assert.Positive(stats.Clauses)
+	assert.Positive(stats.Conflicts)
+	assert.GreaterOrEqual(stats.ParseMicros, int64(0))
+	assert.GreaterOrEqual(stats.ComputeMicros, int64(0))
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("dnnf/compilation?stats=true"), `{"formulas": [{"formula": "(A | B) & (B | C)"}]}`)
+	assert.Nil(err)
+	var dnnf sio.FormulaResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&dnnf))
+	assert.Equal(3, dnnf.State.Stats.Variables)
+	assert.Positive(dnnf.State.Stats.DNNFSize)
+
+	response, err = callServiceJSON(ctx, http.MethodPost, endpoint("solver/maxsat?stats=true"), maxSatInput)
+	assert.Nil(err)
+	var maxsat sio.MaxSatResult
+	assert.Nil(json.NewDecoder(response.Body).Decode(&maxsat))
+	assert.Positive(maxsat.State.Stats.MaxSatIterations)
+
+	response, err = callServiceProtoBuf(ctx, http.MethodPost, endpoint("bdd/compilation?output=bdd&stats=true"), pbFormulaInput("(A | B) & (B | C)"))
+	assert.Nil(err)
+	validateSuccess(t, response, "application/protobuf")
+	data, err := io.ReadAll(response.Body)
+	assert.Nil(err)
+	bdd, err := sio.BDDResult{}.DeserProtoBuf(data)
+	assert.Nil(err)
+	assert.Equal(3, bdd.State.Stats.Variables)
+	assert.Equal(4, bdd.State.Stats.BDDNodes)
+}
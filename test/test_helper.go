@@ -46,7 +46,7 @@ func callServiceJSON(
 	endpoint string,
 	body string,
 ) (*http.Response, error) {
-	return callService(ctx, method, endpoint, []byte(body), "application/json")
+	return callService(ctx, method, endpoint, []byte(body), "application/json", "application/json")
 }
 
 func callServiceProtoBuf(
@@ -55,7 +55,43 @@ func callServiceProtoBuf(
 	endpoint string,
 	body []byte,
 ) (*http.Response, error) {
-	return callService(ctx, method, endpoint, body, "application/protobuf")
+	return callService(ctx, method, endpoint, body, "application/protobuf", "application/protobuf")
+}
+
+func callServiceOPB(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	body string,
+) (*http.Response, error) {
+	return callService(ctx, method, endpoint, []byte(body), "application/opb", "application/json")
+}
+
+func callServiceSMT2(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	body string,
+) (*http.Response, error) {
+	return callService(ctx, method, endpoint, []byte(body), "application/smt2", "application/json")
+}
+
+func callServiceAIGER(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+) (*http.Response, error) {
+	return callService(ctx, method, endpoint, body, "application/aiger", "application/json")
+}
+
+func callServiceNNF(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	body string,
+) (*http.Response, error) {
+	return callService(ctx, method, endpoint, []byte(body), "application/nnf", "application/json")
 }
 
 // Taken from the great article at:
@@ -66,12 +102,13 @@ func callService(
 	endpoint string,
 	body []byte,
 	content string,
+	accept string,
 ) (*http.Response, error) {
 	client := http.Client{}
 	startTime := time.Now()
 	for {
 		req, _ := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
-		req.Header.Set("accept", content)
+		req.Header.Set("accept", accept)
 		req.Header.Set("Content-Type", content)
 		resp, err := client.Do(req)
 		if err != nil {